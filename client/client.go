@@ -0,0 +1,100 @@
+// Package client talks to the advent of code website
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	Year           = 2023
+
+	// SessionEnvVar holds the session cookie of a logged in advent of code account
+	SessionEnvVar = "AOC_SESSION"
+	// BaseURLEnvVar can point the client at a different server, like a local stand-in
+	BaseURLEnvVar = "AOC_BASE_URL"
+
+	userAgent = "github.com/mellena1/advent-of-code-2023"
+)
+
+var (
+	ErrNoSession = errors.New(SessionEnvVar + " is not set")
+)
+
+type Client struct {
+	BaseURL    string
+	Session    string
+	HTTPClient *http.Client
+}
+
+func New(baseURL, session string) *Client {
+	return &Client{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		Session: session,
+		HTTPClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// NewFromEnv makes a client from the session and base url env vars
+func NewFromEnv() (*Client, error) {
+	session := os.Getenv(SessionEnvVar)
+	if session == "" {
+		return nil, ErrNoSession
+	}
+
+	baseURL := os.Getenv(BaseURLEnvVar)
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	return New(baseURL, session), nil
+}
+
+// FetchInput downloads the puzzle input for a day
+func (c *Client) FetchInput(day int) ([]byte, error) {
+	req, err := c.newRequest(http.MethodGet, c.dayURL(day)+"/input", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch input for day %d: %w", day, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input for day %d: %w", day, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch input for day %d: %s: %s", day, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return body, nil
+}
+
+func (c *Client) dayURL(day int) string {
+	return fmt.Sprintf("%s/%d/day/%d", c.BaseURL, Year, day)
+}
+
+func (c *Client) newRequest(method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", userAgent)
+
+	return req, nil
+}
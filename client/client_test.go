@@ -0,0 +1,58 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchInput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+
+		if r.URL.Path != "/2023/day/5/input" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Write([]byte("seeds: 1 2 3\n"))
+	}))
+	defer server.Close()
+
+	input, err := New(server.URL, "secret").FetchInput(5)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(input) != "seeds: 1 2 3\n" {
+		t.Errorf("got input %q", input)
+	}
+
+	if _, err := New(server.URL, "wrong").FetchInput(5); err == nil {
+		t.Error("expected error with a bad session")
+	}
+
+	if _, err := New(server.URL, "secret").FetchInput(26); err == nil {
+		t.Error("expected error for a day that doesn't exist")
+	}
+}
+
+func TestNewFromEnv(t *testing.T) {
+	t.Setenv(SessionEnvVar, "")
+	if _, err := NewFromEnv(); err != ErrNoSession {
+		t.Errorf("expected ErrNoSession, got %v", err)
+	}
+
+	t.Setenv(SessionEnvVar, "secret")
+	t.Setenv(BaseURLEnvVar, "http://localhost:1234/")
+	c, err := NewFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if c.BaseURL != "http://localhost:1234" || c.Session != "secret" {
+		t.Errorf("unexpected client %+v", c)
+	}
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is the site's response to a submitted answer
+type Verdict string
+
+const (
+	VerdictRight       Verdict = "right"
+	VerdictWrong       Verdict = "wrong"
+	VerdictTooHigh     Verdict = "too high"
+	VerdictTooLow      Verdict = "too low"
+	VerdictRateLimited Verdict = "rate limited"
+	// VerdictAlreadySolved means the part was already solved, so the answer wasn't checked
+	VerdictAlreadySolved Verdict = "already solved"
+	VerdictUnknown       Verdict = "unknown"
+)
+
+// IsWrong is true for any verdict that means the answer is not the solution
+func (v Verdict) IsWrong() bool {
+	return v == VerdictWrong || v == VerdictTooHigh || v == VerdictTooLow
+}
+
+type SubmitResult struct {
+	Verdict Verdict
+	// Wait is how long the site says to wait before submitting again, if it said
+	Wait time.Duration
+	// Message is the text of the site's response
+	Message string
+}
+
+var (
+	articleRe = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRe     = regexp.MustCompile(`<[^>]*>`)
+	spaceRe   = regexp.MustCompile(`\s+`)
+	waitRe    = regexp.MustCompile(`(?:(\d+)m\s*)?(\d+)s left to wait`)
+	minutesRe = regexp.MustCompile(`(?:wait|of) (one|\d+) minutes?`)
+)
+
+// Submit posts an answer for one part of a day's puzzle and returns the site's verdict
+func (c *Client) Submit(day, part int, answer string) (SubmitResult, error) {
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+
+	req, err := c.newRequest(http.MethodPost, c.dayURL(day)+"/answer", strings.NewReader(form.Encode()))
+	if err != nil {
+		return SubmitResult{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return SubmitResult{}, fmt.Errorf("failed to submit day %d part %d: %w", day, part, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return SubmitResult{}, fmt.Errorf("failed to read response for day %d part %d: %w", day, part, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return SubmitResult{}, fmt.Errorf("failed to submit day %d part %d: %s: %s", day, part, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return ParseSubmitResponse(string(body)), nil
+}
+
+// ParseSubmitResponse works out the verdict from the page the site returns after submitting an answer
+func ParseSubmitResponse(page string) SubmitResult {
+	msg := page
+	if m := articleRe.FindStringSubmatch(page); m != nil {
+		msg = m[1]
+	}
+	msg = tagRe.ReplaceAllString(msg, "")
+	msg = strings.TrimSpace(spaceRe.ReplaceAllString(msg, " "))
+
+	result := SubmitResult{
+		Verdict: VerdictUnknown,
+		Message: msg,
+		Wait:    parseWait(msg),
+	}
+
+	switch {
+	case strings.Contains(msg, "That's the right answer"):
+		result.Verdict = VerdictRight
+	case strings.Contains(msg, "answer too recently"):
+		result.Verdict = VerdictRateLimited
+	case strings.Contains(msg, "solving the right level"):
+		result.Verdict = VerdictAlreadySolved
+	case strings.Contains(msg, "not the right answer"):
+		switch {
+		case strings.Contains(msg, "too high"):
+			result.Verdict = VerdictTooHigh
+		case strings.Contains(msg, "too low"):
+			result.Verdict = VerdictTooLow
+		default:
+			result.Verdict = VerdictWrong
+		}
+	}
+
+	return result
+}
+
+// parseWait finds how long the message says to wait, either "1m 24s left to wait" or "please wait one minute"
+func parseWait(msg string) time.Duration {
+	if m := waitRe.FindStringSubmatch(msg); m != nil {
+		mins, _ := strconv.Atoi(m[1])
+		secs, _ := strconv.Atoi(m[2])
+		return time.Duration(mins)*time.Minute + time.Duration(secs)*time.Second
+	}
+
+	if m := minutesRe.FindStringSubmatch(msg); m != nil {
+		if m[1] == "one" {
+			return time.Minute
+		}
+		mins, _ := strconv.Atoi(m[1])
+		return time.Duration(mins) * time.Minute
+	}
+
+	return 0
+}
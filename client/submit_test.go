@@ -0,0 +1,93 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	rightPage   = `<main><article><p>That's the right answer!  You are <span class="day-success">one gold star</span> closer to restoring snow operations. <a href="/2023/day/5#part2">[Continue to Part Two]</a></p></article></main>`
+	tooHighPage = `<main><article><p>That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data; there are also some general tips on the <a href="/2023/about">about page</a>, or you can ask for hints on the <a href="https://www.reddit.com/r/adventofcode/" target="_blank">subreddit</a>.  Please wait one minute before trying again. <a href="/2023/day/5">[Return to Day 5]</a></p></article></main>`
+	tooLowPage  = `<main><article><p>That's not the right answer; your answer is too low.  Please wait one minute before trying again. <a href="/2023/day/5">[Return to Day 5]</a></p></article></main>`
+	wrongPage   = `<main><article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data.  Please wait 5 minutes before trying again. <a href="/2023/day/5">[Return to Day 5]</a></p></article></main>`
+	limitedPage = `<main><article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 24s left to wait. <a href="/2023/day/5">[Return to Day 5]</a></p></article></main>`
+	solvedPage  = `<main><article><p>You don't seem to be solving the right level.  Did you already complete it? <a href="/2023/day/5">[Return to Day 5]</a></p></article></main>`
+)
+
+func TestParseSubmitResponse(t *testing.T) {
+	tests := []struct {
+		page    string
+		verdict Verdict
+		wait    time.Duration
+	}{
+		{rightPage, VerdictRight, 0},
+		{tooHighPage, VerdictTooHigh, time.Minute},
+		{tooLowPage, VerdictTooLow, time.Minute},
+		{wrongPage, VerdictWrong, 5 * time.Minute},
+		{limitedPage, VerdictRateLimited, time.Minute + 24*time.Second},
+		{solvedPage, VerdictAlreadySolved, 0},
+		{"<html>something else</html>", VerdictUnknown, 0},
+	}
+
+	for _, tt := range tests {
+		result := ParseSubmitResponse(tt.page)
+		if result.Verdict != tt.verdict {
+			t.Errorf("got verdict %q, want %q for message %q", result.Verdict, tt.verdict, result.Message)
+		}
+		if result.Wait != tt.wait {
+			t.Errorf("got wait %s, want %s for message %q", result.Wait, tt.wait, result.Message)
+		}
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2023/day/5/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.FormValue("level") != "2" {
+			w.Write([]byte(solvedPage))
+			return
+		}
+
+		switch r.FormValue("answer") {
+		case "42":
+			w.Write([]byte(rightPage))
+		case "100":
+			w.Write([]byte(tooHighPage))
+		default:
+			w.Write([]byte(tooLowPage))
+		}
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "secret")
+
+	tests := []struct {
+		part    int
+		answer  string
+		verdict Verdict
+	}{
+		{2, "42", VerdictRight},
+		{2, "100", VerdictTooHigh},
+		{2, "1", VerdictTooLow},
+		{1, "42", VerdictAlreadySolved},
+	}
+
+	for _, tt := range tests {
+		result, err := c.Submit(5, tt.part, tt.answer)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if result.Verdict != tt.verdict {
+			t.Errorf("part %d answer %s: got verdict %q, want %q", tt.part, tt.answer, result.Verdict, tt.verdict)
+		}
+	}
+
+	if _, err := c.Submit(6, 1, "42"); err == nil {
+		t.Error("expected error for a 404 response")
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+
+	day05 "github.com/mellena1/advent-of-code-2023/day-05"
+)
+
+func almanacCmd(args []string) error {
+	fs := flag.NewFlagSet("almanac", flag.ExitOnError)
+	seed := fs.Int("seed", 0, "number to follow through the almanac's maps")
+	category := fs.String("category", "seed", "category the number starts in")
+	input := fs.String("input", "", "path to the day 5 puzzle input (default day-05/input.txt, then the input cache)")
+	fs.Parse(args)
+
+	data, err := readInput(5, *input, true)
+	if err != nil {
+		return fmt.Errorf("failed to read input: %w", err)
+	}
+
+	almanac, err := day05.ParseAlmanac(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to parse input: %w", err)
+	}
+
+	trace, err := almanac.Trace(*category, *seed)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range trace {
+		fmt.Printf("%-12s %d\n", t.Category, t.Num)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mellena1/advent-of-code-2023/days"
+	"github.com/mellena1/advent-of-code-2023/inputs"
+	"github.com/mellena1/advent-of-code-2023/solver"
+)
+
+type measurement struct {
+	duration time.Duration
+	allocs   uint64
+	bytes    uint64
+}
+
+func benchCmd(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	day := fs.Int("day", 0, "day of the puzzle to benchmark (1-25), all days with a local or cached input if unset")
+	count := fs.Int("count", 5, "number of times to run each step, the fastest run is reported")
+	fs.Parse(args)
+
+	if *count < 1 {
+		return fmt.Errorf("count must be at least 1")
+	}
+
+	daysToRun := []int{*day}
+	if *day == 0 {
+		daysToRun = []int{}
+		for d := 1; d <= 25; d++ {
+			daysToRun = append(daysToRun, d)
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "day\tstep\ttime (ms)\tallocs\tbytes\t")
+
+	for _, d := range daysToRun {
+		data, err := readInput(d, "", false)
+		if errors.Is(err, inputs.ErrNotCached) && *day == 0 {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read input: %w", err)
+		}
+
+		for _, step := range []string{"parse", "part1", "part2"} {
+			m, err := benchStep(d, step, data, *count)
+			if errors.Is(err, solver.ErrNoSuchPart) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("day %d %s: %w", d, step, err)
+			}
+
+			fmt.Fprintf(w, "%02d\t%s\t%.3f\t%d\t%d\t\n", d, step, float64(m.duration.Microseconds())/1000, m.allocs, m.bytes)
+		}
+	}
+
+	return w.Flush()
+}
+
+// benchStep runs one step of a day count times with a freshly parsed solver each time,
+// and returns the fastest run
+func benchStep(day int, step string, data []byte, count int) (measurement, error) {
+	var fastest measurement
+
+	for i := 0; i < count; i++ {
+		s, err := days.New(day)
+		if err != nil {
+			return measurement{}, err
+		}
+
+		parse := func() error {
+			return s.Parse(bytes.NewReader(data))
+		}
+
+		var m measurement
+		switch step {
+		case "parse":
+			m, err = measure(parse)
+		case "part1", "part2":
+			if err := parse(); err != nil {
+				return measurement{}, err
+			}
+
+			part := 1
+			if step == "part2" {
+				part = 2
+			}
+			m, err = measure(func() error {
+				_, err := solver.SolvePart(s, part)
+				return err
+			})
+		}
+		if err != nil {
+			return measurement{}, err
+		}
+
+		if i == 0 || m.duration < fastest.duration {
+			fastest = m
+		}
+	}
+
+	return fastest, nil
+}
+
+func measure(f func() error) (measurement, error) {
+	var before, after runtime.MemStats
+
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	start := time.Now()
+	err := f()
+	elapsed := time.Since(start)
+
+	runtime.ReadMemStats(&after)
+
+	return measurement{
+		duration: elapsed,
+		allocs:   after.Mallocs - before.Mallocs,
+		bytes:    after.TotalAlloc - before.TotalAlloc,
+	}, err
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+
+	"github.com/mellena1/advent-of-code-2023/days"
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+func graphCmd(args []string) error {
+	fs := flag.NewFlagSet("graph", flag.ExitOnError)
+	day := fs.Int("day", 0, "day of the puzzle whose graph to export (1-25)")
+	format := fs.String("format", string(utils.GraphFormatDOT), fmt.Sprintf("format to export the graph in %v", utils.GraphFormats))
+	input := fs.String("input", "", "path to the puzzle input (default day-NN/input.txt, then the input cache)")
+	fs.Parse(args)
+
+	graphFormat, err := utils.ParseGraphFormat(*format)
+	if err != nil {
+		return err
+	}
+
+	s, err := days.New(*day)
+	if err != nil {
+		return err
+	}
+
+	data, err := readInput(*day, *input, true)
+	if err != nil {
+		return fmt.Errorf("failed to read input: %w", err)
+	}
+
+	if err := s.Parse(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("failed to parse input: %w", err)
+	}
+
+	out, err := solver.ExportGraph(s, graphFormat)
+	if err != nil {
+		return fmt.Errorf("day %d: %w", *day, err)
+	}
+	fmt.Print(out)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/mellena1/advent-of-code-2023/client"
+	"github.com/mellena1/advent-of-code-2023/inputs"
+)
+
+func fetchCmd(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	day := fs.Int("day", 0, "day of the puzzle input to download (1-25)")
+	fs.Parse(args)
+
+	if *day < 1 || *day > 25 {
+		return fmt.Errorf("invalid day %d", *day)
+	}
+
+	store, err := newInputStore(true)
+	if err != nil {
+		return err
+	}
+
+	if err := store.Fetch(*day); err != nil {
+		return err
+	}
+
+	fmt.Printf("Saved input to %s\n", store.Path(*day))
+	return nil
+}
+
+// readInput reads the input at path if one is given. Otherwise it reads day-NN/input.txt,
+// and if that doesn't exist, the input cache, downloading the input if allowFetch is set.
+func readInput(day int, path string, allowFetch bool) ([]byte, error) {
+	if path != "" {
+		return os.ReadFile(path)
+	}
+
+	data, err := os.ReadFile(defaultInputPath(day))
+	if !errors.Is(err, os.ErrNotExist) {
+		return data, err
+	}
+
+	store, err := newInputStore(allowFetch)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = store.Get(day)
+	if errors.Is(err, inputs.ErrNotCached) && allowFetch {
+		return nil, fmt.Errorf("%w, set %s to download it", err, client.SessionEnvVar)
+	}
+	return data, err
+}
+
+func newInputStore(allowFetch bool) (*inputs.Store, error) {
+	dir, err := inputs.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+
+	store := inputs.NewStore(dir, nil)
+	if !allowFetch {
+		return store, nil
+	}
+
+	c, err := client.NewFromEnv()
+	if errors.Is(err, client.ErrNoSession) {
+		// without a session only already cached inputs can be used
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	store.Fetcher = c
+
+	return store, nil
+}
+
+func defaultInputPath(day int) string {
+	return fmt.Sprintf("day-%02d/input.txt", day)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands = []command{
+	{name: "run", usage: "solve a day's puzzle", run: runCmd},
+	{name: "bench", usage: "time parsing and solving each day", run: benchCmd},
+	{name: "fetch", usage: "download a day's input into the input cache", run: fetchCmd},
+	{name: "submit", usage: "solve a day's puzzle and submit the answer", run: submitCmd},
+	{name: "graph", usage: "export a day's puzzle input as a graph", run: graphCmd},
+	{name: "almanac", usage: "follow a seed through day 5's almanac", run: almanacCmd},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != os.Args[1] {
+			continue
+		}
+
+		if err := cmd.run(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", cmd.name, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "unknown command %q\n", os.Args[1])
+	printUsage()
+	os.Exit(2)
+}
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", cmd.name, cmd.usage)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/mellena1/advent-of-code-2023/days"
+	"github.com/mellena1/advent-of-code-2023/solver"
+)
+
+func runCmd(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	day := fs.Int("day", 0, "day of the puzzle to solve (1-25)")
+	part := fs.Int("part", 0, "part of the puzzle to solve (1 or 2), both if unset")
+	input := fs.String("input", "", "path to the puzzle input (default day-NN/input.txt, then the input cache)")
+	fs.Parse(args)
+
+	if *part < 0 || *part > 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+
+	s, err := days.New(*day)
+	if err != nil {
+		return err
+	}
+
+	data, err := readInput(*day, *input, true)
+	if err != nil {
+		return fmt.Errorf("failed to read input: %w", err)
+	}
+
+	if err := s.Parse(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("failed to parse input: %w", err)
+	}
+
+	for _, p := range []int{1, 2} {
+		if *part != 0 && *part != p {
+			continue
+		}
+
+		answer, err := solver.SolvePart(s, p)
+		if errors.Is(err, solver.ErrNoSuchPart) && *part == 0 {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("part %d: %w", p, err)
+		}
+		fmt.Printf("Part %d answer: %s\n", p, answer)
+	}
+
+	return nil
+}
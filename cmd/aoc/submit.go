@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+
+	"github.com/mellena1/advent-of-code-2023/client"
+	"github.com/mellena1/advent-of-code-2023/days"
+	"github.com/mellena1/advent-of-code-2023/ledger"
+	"github.com/mellena1/advent-of-code-2023/solver"
+)
+
+func submitCmd(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	day := fs.Int("day", 0, "day of the puzzle to submit (1-25)")
+	part := fs.Int("part", 0, "part of the puzzle to submit (1 or 2)")
+	input := fs.String("input", "", "path to the puzzle input (default day-NN/input.txt, then the input cache)")
+	ledgerPath := fs.String("ledger", "", "path to the ledger of submitted answers (default $AOC_LEDGER, then ~/.config/aoc/2023/ledger.json)")
+	fs.Parse(args)
+
+	if *part != 1 && *part != 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+
+	s, err := days.New(*day)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.NewFromEnv()
+	if err != nil {
+		return err
+	}
+
+	if *ledgerPath == "" {
+		*ledgerPath, err = ledger.DefaultPath()
+		if err != nil {
+			return err
+		}
+	}
+	l, err := ledger.Load(*ledgerPath)
+	if err != nil {
+		return err
+	}
+
+	data, err := readInput(*day, *input, true)
+	if err != nil {
+		return fmt.Errorf("failed to read input: %w", err)
+	}
+
+	if err := s.Parse(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("failed to parse input: %w", err)
+	}
+
+	answer, err := solver.SolvePart(s, *part)
+	if err != nil {
+		return fmt.Errorf("part %d: %w", *part, err)
+	}
+	fmt.Printf("Part %d answer: %s\n", *part, answer)
+
+	if err := l.Check(*day, *part, answer); err != nil {
+		return fmt.Errorf("not submitting: %w", err)
+	}
+
+	result, err := c.Submit(*day, *part, answer.String())
+	if err != nil {
+		return err
+	}
+
+	if err := l.Record(*day, *part, answer, result.Verdict); err != nil {
+		return err
+	}
+
+	switch result.Verdict {
+	case client.VerdictRight:
+		fmt.Println("That's the right answer!")
+	case client.VerdictWrong, client.VerdictTooHigh, client.VerdictTooLow:
+		fmt.Printf("Wrong answer (%s)\n", result.Verdict)
+	case client.VerdictRateLimited:
+		return fmt.Errorf("rate limited, try again in %s", result.Wait)
+	case client.VerdictAlreadySolved:
+		fmt.Println("Already solved, the answer wasn't checked")
+	default:
+		return fmt.Errorf("couldn't understand the response: %s", result.Message)
+	}
+
+	if result.Verdict.IsWrong() && result.Wait > 0 {
+		fmt.Printf("Wait %s before submitting again\n", result.Wait)
+	}
+
+	return nil
+}
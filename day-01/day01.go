@@ -1,38 +1,52 @@
-package main
+package day01
 
 import (
 	"fmt"
+	"io"
 	"strconv"
 	"unicode"
 
+	"github.com/mellena1/advent-of-code-2023/solver"
 	"github.com/mellena1/advent-of-code-2023/utils"
 )
 
-func main() {
-	f := utils.ReadFile("input.txt")
-	defer f.Close()
+type Solver struct {
+	lines []string
+}
 
-	partOneSum := 0
-	partTwoSum := 0
+func New() solver.Solver {
+	return &Solver{}
+}
 
-	utils.ExecutePerLine(f, func(line string) error {
-		num, err := getNumFromLine(line, false)
-		if err != nil {
-			return fmt.Errorf("unexpected error parsing line p1: %s, err: %s", line, err)
-		}
-		partOneSum += num
-
-		num2, err := getNumFromLine(line, true)
-		if err != nil {
-			return fmt.Errorf("unexpected error parsing line p2: %s, err: %s", line, err)
-		}
-		partTwoSum += num2
+func (s *Solver) Parse(r io.Reader) error {
+	s.lines = []string{}
 
+	return utils.ForEachLine(r, func(line string) error {
+		s.lines = append(s.lines, line)
 		return nil
 	})
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	return s.sumCalibrationValues(false)
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	return s.sumCalibrationValues(true)
+}
+
+func (s *Solver) sumCalibrationValues(lookForWords bool) (solver.Answer, error) {
+	sum := 0
+
+	for _, line := range s.lines {
+		num, err := getNumFromLine(line, lookForWords)
+		if err != nil {
+			return solver.Answer{}, fmt.Errorf("unexpected error parsing line %s, err: %s", line, err)
+		}
+		sum += num
+	}
 
-	fmt.Printf("Part 1 answer: %d\n", partOneSum)
-	fmt.Printf("Part 2 answer: %d\n", partTwoSum)
+	return solver.Int(sum), nil
 }
 
 func getNumFromLine(line string, lookForWords bool) (int, error) {
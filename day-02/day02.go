@@ -0,0 +1,169 @@
+package day02
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+type cubeAmounts map[string]int
+
+var allowedAmts = cubeAmounts{
+	"red":   12,
+	"green": 13,
+	"blue":  14,
+}
+
+var (
+	ErrBadGame = errors.New("bad game")
+)
+
+type Solver struct {
+	games []Game
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	s.games = []Game{}
+
+	return utils.ForEachLine(r, func(line string) error {
+		game, err := parseGame(line)
+		if err != nil {
+			return fmt.Errorf("failed to parse game: %w", err)
+		}
+		s.games = append(s.games, game)
+		return nil
+	})
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	sum := 0
+
+	for _, game := range s.games {
+		if game.isPossible(allowedAmts) {
+			sum += game.ID
+		}
+	}
+
+	return solver.Int(sum), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	sum := 0
+
+	for _, game := range s.games {
+		sum += game.power()
+	}
+
+	return solver.Int(sum), nil
+}
+
+type Game struct {
+	ID    int
+	Pulls []cubeAmounts
+}
+
+func parseGame(line string) (Game, error) {
+	game, details, ok := strings.Cut(line, ":")
+	gameFields := strings.Fields(game)
+	if !ok || len(gameFields) != 2 || gameFields[0] != "Game" {
+		return Game{}, fmt.Errorf("%w: expected Game N: pulls", ErrBadGame)
+	}
+	gameId, err := strconv.Atoi(gameFields[1])
+	if err != nil {
+		return Game{}, fmt.Errorf("invalid game id %q: %w", gameFields[1], err)
+	}
+
+	parsedPulls := []cubeAmounts{}
+
+	pulls := strings.Split(strings.TrimSpace(details), ";")
+	for i := range pulls {
+		pulls[i] = strings.TrimSpace(pulls[i])
+
+		parsedPull, err := parsePull(pulls[i])
+		if err != nil {
+			return Game{}, err
+		}
+
+		parsedPulls = append(parsedPulls, parsedPull)
+	}
+
+	return Game{ID: gameId, Pulls: parsedPulls}, nil
+}
+
+func (g Game) isPossible(allowed cubeAmounts) bool {
+	for _, pull := range g.Pulls {
+		if !pullIsPossible(allowed, pull) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (g Game) power() int {
+	minsNeeded := cubeAmounts{}
+
+	for _, pull := range g.Pulls {
+		for color, num := range pull {
+			if curNeeded, ok := minsNeeded[color]; ok {
+				if num > curNeeded {
+					minsNeeded[color] = num
+				}
+			} else {
+				minsNeeded[color] = num
+			}
+		}
+	}
+
+	power := 1
+	for _, needed := range minsNeeded {
+		power *= needed
+	}
+
+	return power
+}
+
+func pullIsPossible(allowed cubeAmounts, pull cubeAmounts) bool {
+	for color, num := range pull {
+		if num > allowed[color] {
+			return false
+		}
+	}
+
+	return true
+}
+
+var pullRegex = regexp.MustCompile(`(\d+) (blue|red|green)`)
+
+func parsePull(pull string) (cubeAmounts, error) {
+	cubes := strings.Split(pull, ",")
+
+	cubeAmts := cubeAmounts{}
+
+	for _, cubePull := range cubes {
+		matches := pullRegex.FindStringSubmatch(cubePull)
+		if matches == nil {
+			return nil, fmt.Errorf("%w: invalid pull %q", ErrBadGame, cubePull)
+		}
+
+		num, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil, fmt.Errorf("not a number %q: %w", matches[1], err)
+		}
+		color := matches[2]
+
+		cubeAmts[color] = num
+	}
+
+	return cubeAmts, nil
+}
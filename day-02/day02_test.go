@@ -0,0 +1,50 @@
+package day02
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(8),
+			Part2: solver.Int(2286),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
+
+func TestParseBadInput(t *testing.T) {
+	inputs := []string{
+		"Game 1 3 blue, 4 red",
+		"1: 3 blue, 4 red",
+		"Game 1: 3 blue, 4 purple",
+		"Game 1: 3 blue; ; 4 red",
+	}
+
+	for _, input := range inputs {
+		err := New().Parse(strings.NewReader("Game 1: 1 red\n" + input))
+		if !errors.Is(err, ErrBadGame) {
+			t.Errorf("%q: expected %v, got %v", input, ErrBadGame, err)
+		}
+
+		var parseErr *utils.ParseError
+		if !errors.As(err, &parseErr) || parseErr.Line != 2 {
+			t.Errorf("%q: expected a *utils.ParseError on line 2, got %v", input, err)
+		}
+	}
+}
@@ -1,12 +1,12 @@
-package main
+package day03
 
 import (
 	"fmt"
 	"io"
-	"os"
 	"strconv"
 	"unicode"
 
+	"github.com/mellena1/advent-of-code-2023/solver"
 	"github.com/mellena1/advent-of-code-2023/utils"
 )
 
@@ -17,43 +17,42 @@ const (
 	gear   = -3
 )
 
-func main() {
-	f := utils.ReadFile("input.txt")
-	defer f.Close()
+type Solver struct {
+	board [][]int
+}
 
-	board := getBoard(f)
+func New() solver.Solver {
+	return &Solver{}
+}
 
-	partNums, err := findPartNumbers(board)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "failed to get part numbers: %s\n", err)
-		os.Exit(1)
-	}
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.board, err = getBoard(r)
+	return err
+}
 
-	partOneSum := 0
-	for _, partNum := range partNums {
-		partOneSum += partNum
+func (s *Solver) Part1() (solver.Answer, error) {
+	partNums, err := findPartNumbers(s.board)
+	if err != nil {
+		return solver.Answer{}, fmt.Errorf("failed to get part numbers: %w", err)
 	}
 
-	fmt.Printf("Part one solution: %d\n", partOneSum)
+	return solver.Int(utils.IntSliceSum(partNums)), nil
+}
 
-	gearRatios, err := findGearRatios(board)
+func (s *Solver) Part2() (solver.Answer, error) {
+	gearRatios, err := findGearRatios(s.board)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "failed to get gear ratios: %s\n", err)
-		os.Exit(1)
-	}
-
-	partTwoSum := 0
-	for _, gearRatio := range gearRatios {
-		partTwoSum += gearRatio
+		return solver.Answer{}, fmt.Errorf("failed to get gear ratios: %w", err)
 	}
 
-	fmt.Printf("Part two solution: %d\n", partTwoSum)
+	return solver.Int(utils.IntSliceSum(gearRatios)), nil
 }
 
-func getBoard(f io.Reader) [][]int {
+func getBoard(f io.Reader) ([][]int, error) {
 	board := [][]int{}
 
-	utils.ExecutePerLine(f, func(line string) error {
+	err := utils.ForEachLine(f, func(line string) error {
 		newLine := []int{}
 		numBuilder := []rune{}
 
@@ -105,8 +104,11 @@ func getBoard(f io.Reader) [][]int {
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return board
+	return board, nil
 }
 
 func findPartNumbers(board [][]int) ([]int, error) {
@@ -0,0 +1,26 @@
+package day03
+
+import (
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(4361),
+			Part2: solver.Int(467835),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
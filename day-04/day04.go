@@ -1,59 +1,84 @@
-package main
+package day04
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/mellena1/advent-of-code-2023/solver"
 	"github.com/mellena1/advent-of-code-2023/utils"
 )
 
-func main() {
-	f := utils.ReadFile("input.txt")
-	defer f.Close()
+var (
+	ErrBadCard = errors.New("bad card")
+)
 
-	games := getGamesFromText(f)
+type Solver struct {
+	games []Game
+}
 
-	partOneSum := 0
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.games, err = getGamesFromText(r)
+	return err
+}
 
-	for _, game := range games {
-		partOneSum += game.getScore()
+func (s *Solver) Part1() (solver.Answer, error) {
+	sum := 0
+	for _, game := range s.games {
+		sum += game.getScore()
 	}
-	fmt.Printf("Part one sum: %d\n", partOneSum)
+	return solver.Int(sum), nil
+}
 
-	partTwoSum := calcNumberOfCardsWithCopies(games)
-	fmt.Printf("Part two sum: %d\n", partTwoSum)
+func (s *Solver) Part2() (solver.Answer, error) {
+	return solver.Int(calcNumberOfCardsWithCopies(s.games)), nil
 }
 
-func getGamesFromText(f io.Reader) []Game {
+func getGamesFromText(f io.Reader) ([]Game, error) {
 	games := []Game{}
 
-	utils.ExecutePerLine(f, func(line string) error {
+	err := utils.ForEachLine(f, func(line string) error {
 		game, err := getGameFromLine(line)
 		if err != nil {
-			return fmt.Errorf("failed to get game from line %q: %s", line, err)
+			return fmt.Errorf("failed to get game: %w", err)
 		}
 
 		games = append(games, game)
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return games
+	return games, nil
 }
 
 func getGameFromLine(line string) (Game, error) {
-	splitOnColon := strings.Split(line, ":")
-	gameID, err := strconv.Atoi(strings.Fields(splitOnColon[0])[1])
+	card, nums, ok := strings.Cut(line, ":")
+	cardFields := strings.Fields(card)
+	if !ok || len(cardFields) != 2 || cardFields[0] != "Card" {
+		return Game{}, fmt.Errorf("%w: expected Card N: winning | numbers", ErrBadCard)
+	}
+	gameID, err := strconv.Atoi(cardFields[1])
 	if err != nil {
 		return Game{}, fmt.Errorf("invalid game id: %w", err)
 	}
 
-	splitOnBar := strings.Split(splitOnColon[1], "|")
-	winningNumsStrs := strings.Fields(splitOnBar[0])
-	playerNumsStrs := strings.Fields(splitOnBar[1])
+	winning, player, ok := strings.Cut(nums, "|")
+	if !ok {
+		return Game{}, fmt.Errorf("%w: no | between the winning numbers and yours", ErrBadCard)
+	}
+	winningNumsStrs := strings.Fields(winning)
+	playerNumsStrs := strings.Fields(player)
 
 	winningNums, err := utils.StrSliceToIntSlice(winningNumsStrs)
 	if err != nil {
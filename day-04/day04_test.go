@@ -0,0 +1,50 @@
+package day04
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(13),
+			Part2: solver.Int(30),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
+
+func TestParseBadInput(t *testing.T) {
+	inputs := []string{
+		"Card 1 41 48 | 83 86",
+		"Card 1: 41 48 83 86",
+		"1: 41 48 | 83 86",
+		"Game 1: 41 48 | 83 86",
+	}
+
+	for _, input := range inputs {
+		err := New().Parse(strings.NewReader(input))
+		if !errors.Is(err, ErrBadCard) {
+			t.Errorf("%q: expected %v, got %v", input, ErrBadCard, err)
+		}
+
+		var parseErr *utils.ParseError
+		if !errors.As(err, &parseErr) || parseErr.Line != 1 {
+			t.Errorf("%q: expected a *utils.ParseError on line 1, got %v", input, err)
+		}
+	}
+}
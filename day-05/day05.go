@@ -0,0 +1,293 @@
+package day05
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+var (
+	ErrNoSeeds         = errors.New("almanac has no seeds")
+	ErrUnknownCategory = errors.New("almanac has no such category")
+	ErrNoTranslation   = errors.New("almanac can't translate between categories")
+)
+
+type Solver struct {
+	almanac Almanac
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.almanac, err = ParseAlmanac(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	lowest := math.MaxInt
+	for _, seed := range s.almanac.Seeds {
+		loc := s.almanac.GetSeedLocation(seed)
+		if loc < lowest {
+			lowest = loc
+		}
+	}
+	return solver.Int(lowest), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	seeds := utils.NewIntervalSet()
+	for i := 0; i+1 < len(s.almanac.Seeds); i += 2 {
+		seeds = seeds.Add(utils.NewIntervalLen(s.almanac.Seeds[i], s.almanac.Seeds[i+1]))
+	}
+
+	locations, err := s.almanac.GetLocations(seeds)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	lowest, ok := locations.Min()
+	if !ok {
+		return solver.Answer{}, ErrNoSeeds
+	}
+	return solver.Int(lowest), nil
+}
+
+// XToYMap translates numbers from one category to another
+type XToYMap struct {
+	From     string
+	To       string
+	Mappings []Mapping
+}
+
+// MapIntervals maps every number in nums at once, splitting up intervals wherever they go through
+// different mappings. Numbers that aren't in any mapping's source range stay the same.
+func (m XToYMap) MapIntervals(nums utils.IntervalSet) utils.IntervalSet {
+	mapped := utils.NewIntervalSet()
+	unmapped := nums
+
+	for _, mapping := range m.Mappings {
+		src := utils.NewIntervalSet(mapping.source())
+		mapped = mapped.Union(unmapped.Intersect(src).Shift(mapping.DestRangeStart - mapping.SourceRangeStart))
+		unmapped = unmapped.Subtract(src)
+	}
+
+	return mapped.Union(unmapped)
+}
+
+// ReverseMapIntervals finds every number that MapIntervals would map into nums
+func (m XToYMap) ReverseMapIntervals(nums utils.IntervalSet) utils.IntervalSet {
+	// numbers outside of every source range map to themselves
+	sources := utils.NewIntervalSet(utils.SliceMap(m.Mappings, Mapping.source)...)
+	result := nums.Subtract(sources)
+
+	for _, mapping := range m.Mappings {
+		dest := utils.NewIntervalSet(mapping.dest())
+		result = result.Union(nums.Intersect(dest).Shift(mapping.SourceRangeStart - mapping.DestRangeStart))
+	}
+
+	return result
+}
+
+func (m XToYMap) mapNum(n int) int {
+	for _, mapping := range m.Mappings {
+		if mapping.source().Contains(n) {
+			return n + mapping.DestRangeStart - mapping.SourceRangeStart
+		}
+	}
+	return n
+}
+
+type Mapping struct {
+	DestRangeStart   int
+	SourceRangeStart int
+	RangeLen         int
+}
+
+func (m Mapping) source() utils.Interval {
+	return utils.NewIntervalLen(m.SourceRangeStart, m.RangeLen)
+}
+
+func (m Mapping) dest() utils.Interval {
+	return utils.NewIntervalLen(m.DestRangeStart, m.RangeLen)
+}
+
+type Almanac struct {
+	Seeds []int
+	Maps  []XToYMap
+}
+
+func (a *Almanac) GetSeedLocation(seed int) int {
+	curNum := seed
+
+	for _, xToYMap := range a.Maps {
+		curNum = xToYMap.mapNum(curNum)
+	}
+
+	return curNum
+}
+
+// GetLocations is GetSeedLocation for a whole set of seeds at once
+func (a *Almanac) GetLocations(seeds utils.IntervalSet) (utils.IntervalSet, error) {
+	return a.Translate("seed", "location", seeds)
+}
+
+// Translate finds what nums in the from category correspond to in the to category. It works in
+// either direction, so going backwards, like from location to seed, finds every number that maps
+// to one of nums.
+func (a *Almanac) Translate(from, to string, nums utils.IntervalSet) (utils.IntervalSet, error) {
+	if chain, err := a.chain(from, to); err == nil {
+		for _, xToYMap := range chain {
+			nums = xToYMap.MapIntervals(nums)
+		}
+		return nums, nil
+	}
+
+	chain, err := a.chain(to, from)
+	if err != nil {
+		return utils.IntervalSet{}, err
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		nums = chain[i].ReverseMapIntervals(nums)
+	}
+	return nums, nil
+}
+
+// Translation is a number in one of the categories
+type Translation struct {
+	Category string
+	Num      int
+}
+
+// Trace follows n from the from category through every map after it
+func (a *Almanac) Trace(from string, n int) ([]Translation, error) {
+	if !slices.Contains(a.Categories(), from) {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCategory, from)
+	}
+
+	trace := []Translation{{Category: from, Num: n}}
+	for {
+		xToYMap, ok := a.mapFrom(from)
+		if !ok {
+			return trace, nil
+		}
+		n = xToYMap.mapNum(n)
+		from = xToYMap.To
+		trace = append(trace, Translation{Category: from, Num: n})
+	}
+}
+
+// Categories lists every category that's mapped to or from
+func (a *Almanac) Categories() []string {
+	categories := []string{}
+	for _, xToYMap := range a.Maps {
+		for _, c := range []string{xToYMap.From, xToYMap.To} {
+			if !slices.Contains(categories, c) {
+				categories = append(categories, c)
+			}
+		}
+	}
+	return categories
+}
+
+func (a *Almanac) mapFrom(category string) (XToYMap, bool) {
+	for _, xToYMap := range a.Maps {
+		if xToYMap.From == category {
+			return xToYMap, true
+		}
+	}
+	return XToYMap{}, false
+}
+
+// chain finds the maps to go through, in order, to get from one category to another
+func (a *Almanac) chain(from, to string) ([]XToYMap, error) {
+	categories := a.Categories()
+	for _, c := range []string{from, to} {
+		if !slices.Contains(categories, c) {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownCategory, c)
+		}
+	}
+
+	chain := []XToYMap{}
+	for cur := from; cur != to; {
+		xToYMap, ok := a.mapFrom(cur)
+		// the maps can't go on for longer than there are of them, unless they loop
+		if !ok || len(chain) == len(a.Maps) {
+			return nil, fmt.Errorf("%w: %s to %s", ErrNoTranslation, from, to)
+		}
+		chain = append(chain, xToYMap)
+		cur = xToYMap.To
+	}
+	return chain, nil
+}
+
+func ParseAlmanac(f io.Reader) (Almanac, error) {
+	almanac := Almanac{
+		Maps: []XToYMap{},
+	}
+
+	err := utils.ForEachLine(f, func(line string) error {
+		// ignore blank lines
+		if len(strings.TrimSpace(line)) == 0 {
+			return nil
+		}
+
+		// parse the seeds
+		if strings.HasPrefix(line, "seeds: ") {
+			seedsStr, _ := strings.CutPrefix(line, "seeds: ")
+
+			seeds, err := utils.StrSliceToIntSlice(strings.Fields(seedsStr))
+			if err != nil {
+				return fmt.Errorf("error parsing seeds %q: %w", line, err)
+			}
+
+			almanac.Seeds = seeds
+			return nil
+		}
+
+		// start up a new x-to-y map
+		if name, ok := strings.CutSuffix(line, " map:"); ok {
+			from, to, ok := strings.Cut(name, "-to-")
+			if !ok {
+				return fmt.Errorf("error parsing map name %q", line)
+			}
+			almanac.Maps = append(almanac.Maps, XToYMap{From: from, To: to})
+			return nil
+		}
+
+		if len(almanac.Maps) == 0 {
+			return fmt.Errorf("mapping %q isn't in a map", line)
+		}
+
+		// parse the number lines
+		nums, err := utils.StrSliceToIntSlice(strings.Fields(line))
+		if err != nil {
+			return fmt.Errorf("error parsing mapping %q: %w", line, err)
+		}
+		if len(nums) != 3 {
+			return fmt.Errorf("error parsing mapping %q: expected 3 numbers", line)
+		}
+
+		curMap := &almanac.Maps[len(almanac.Maps)-1]
+		curMap.Mappings = append(curMap.Mappings, Mapping{
+			DestRangeStart:   nums[0],
+			SourceRangeStart: nums[1],
+			RangeLen:         nums[2],
+		})
+
+		return nil
+	})
+	if err != nil {
+		return Almanac{}, err
+	}
+
+	return almanac, nil
+}
@@ -0,0 +1,111 @@
+package day05
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(35),
+			Part2: solver.Int(46),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
+
+func parseExample(t *testing.T) Almanac {
+	t.Helper()
+
+	f, err := os.Open("testdata/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	almanac, err := ParseAlmanac(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return almanac
+}
+
+func TestTrace(t *testing.T) {
+	almanac := parseExample(t)
+
+	trace, err := almanac.Trace("seed", 79)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Translation{
+		{"seed", 79}, {"soil", 81}, {"fertilizer", 81}, {"water", 81},
+		{"light", 74}, {"temperature", 78}, {"humidity", 78}, {"location", 82},
+	}
+	if !slices.Equal(trace, want) {
+		t.Errorf("got %v, want %v", trace, want)
+	}
+
+	if _, err := almanac.Trace("dirt", 1); !errors.Is(err, ErrUnknownCategory) {
+		t.Errorf("expected ErrUnknownCategory, got %v", err)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	almanac := parseExample(t)
+
+	// every seed should go forward to the same place GetSeedLocation sends it, and the location
+	// should go back to a set of seeds that includes it
+	for seed := 0; seed < 110; seed++ {
+		seedSet := utils.NewIntervalSet(utils.NewIntervalLen(seed, 1))
+
+		locations, err := almanac.Translate("seed", "location", seedSet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		loc := almanac.GetSeedLocation(seed)
+		if locations.Len() != 1 || !locations.Contains(loc) {
+			t.Errorf("seed %d: translated to %s, want %d", seed, locations, loc)
+		}
+
+		seeds, err := almanac.Translate("location", "seed", locations)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !seeds.Contains(seed) {
+			t.Errorf("location %d: translated back to %s, which is missing seed %d", loc, seeds, seed)
+		}
+		for _, i := range seeds.Intervals() {
+			for s := i.Start; s < i.End; s++ {
+				if almanac.GetSeedLocation(s) != loc {
+					t.Errorf("location %d: translated back to seed %d, which goes to %d", loc, s, almanac.GetSeedLocation(s))
+				}
+			}
+		}
+	}
+
+	// part way along the chain works too
+	water, err := almanac.Translate("soil", "water", utils.NewIntervalSet(utils.NewInterval(0, 100)))
+	if err != nil || water.Len() != 100 {
+		t.Errorf("got %s, %v", water, err)
+	}
+
+	if _, err := almanac.Translate("seed", "dirt", water); !errors.Is(err, ErrUnknownCategory) {
+		t.Errorf("expected ErrUnknownCategory, got %v", err)
+	}
+}
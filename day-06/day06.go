@@ -1,4 +1,4 @@
-package main
+package day06
 
 import (
 	"fmt"
@@ -6,24 +6,37 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/mellena1/advent-of-code-2023/solver"
 	"github.com/mellena1/advent-of-code-2023/utils"
 )
 
 const accelIncreasePerMS = 1
 
-func main() {
-	f := utils.ReadFile("input.txt")
-	defer f.Close()
+type Solver struct {
+	races       []Race
+	partTwoRace Race
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
 
-	races, partTwoRace := parseRaces(f)
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.races, s.partTwoRace, err = parseRaces(r)
+	return err
+}
 
-	partOneAnswer := 1
-	for _, race := range races {
-		partOneAnswer *= race.waysToBeatRace()
+func (s *Solver) Part1() (solver.Answer, error) {
+	answer := 1
+	for _, race := range s.races {
+		answer *= race.waysToBeatRace()
 	}
-	fmt.Printf("Part one answer: %d\n", partOneAnswer)
+	return solver.Int(answer), nil
+}
 
-	fmt.Printf("Part two answer: %d\n", partTwoRace.waysToBeatRace())
+func (s *Solver) Part2() (solver.Answer, error) {
+	return solver.Int(s.partTwoRace.waysToBeatRace()), nil
 }
 
 type Race struct {
@@ -48,12 +61,12 @@ func (r Race) waysToBeatRace() int {
 }
 
 // []Race is for part 1, Race is for part 2
-func parseRaces(f io.Reader) ([]Race, Race) {
+func parseRaces(f io.Reader) ([]Race, Race, error) {
 	var times []int
 	var distances []int
 	var partTwoRace Race
 
-	utils.ExecutePerLine(f, func(line string) error {
+	err := utils.ForEachLine(f, func(line string) error {
 		var err error
 		if strings.HasPrefix(line, "Time:") {
 			times, err = parseNumsFromLine(line)
@@ -80,6 +93,9 @@ func parseRaces(f io.Reader) ([]Race, Race) {
 
 		return nil
 	})
+	if err != nil {
+		return nil, Race{}, err
+	}
 
 	races := make([]Race, len(times))
 
@@ -90,7 +106,7 @@ func parseRaces(f io.Reader) ([]Race, Race) {
 		}
 	}
 
-	return races, partTwoRace
+	return races, partTwoRace, nil
 }
 
 func parseNumsFromLine(line string) ([]int, error) {
@@ -1,4 +1,6 @@
-package main
+package day07
+
+import "fmt"
 
 type Card int
 
@@ -18,37 +20,37 @@ const (
 	Ace
 )
 
-func cardFromRune(r rune) Card {
+func cardFromRune(r rune) (Card, error) {
 	switch r {
 	case '2':
-		return Two
+		return Two, nil
 	case '3':
-		return Three
+		return Three, nil
 	case '4':
-		return Four
+		return Four, nil
 	case '5':
-		return Five
+		return Five, nil
 	case '6':
-		return Six
+		return Six, nil
 	case '7':
-		return Seven
+		return Seven, nil
 	case '8':
-		return Eight
+		return Eight, nil
 	case '9':
-		return Nine
+		return Nine, nil
 	case 'T':
-		return Ten
+		return Ten, nil
 	case 'J':
-		return Jack
+		return Jack, nil
 	case 'Q':
-		return Queen
+		return Queen, nil
 	case 'K':
-		return King
+		return King, nil
 	case 'A':
-		return Ace
+		return Ace, nil
 	}
 
-	panic("unknown card: " + string(r))
+	return -1, fmt.Errorf("unknown card: %q", r)
 }
 
 func (c Card) String() string {
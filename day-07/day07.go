@@ -1,4 +1,4 @@
-package main
+package day07
 
 import (
 	"fmt"
@@ -7,20 +7,32 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/mellena1/advent-of-code-2023/solver"
 	"github.com/mellena1/advent-of-code-2023/utils"
 )
 
-func main() {
-	f := utils.ReadFile("input.txt")
-	defer f.Close()
+type Solver struct {
+	hands []Hand
+}
 
-	hands := parseHands(f)
+func New() solver.Solver {
+	return &Solver{}
+}
 
-	sortHands(hands, false)
-	fmt.Printf("Part one solutions: %d\n", multiplyWinnings(hands))
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.hands, err = parseHands(r)
+	return err
+}
 
-	sortHands(hands, true)
-	fmt.Printf("Part two solutions: %d\n", multiplyWinnings(hands))
+func (s *Solver) Part1() (solver.Answer, error) {
+	sortHands(s.hands, false)
+	return solver.Int(multiplyWinnings(s.hands)), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	sortHands(s.hands, true)
+	return solver.Int(multiplyWinnings(s.hands)), nil
 }
 
 type Hand struct {
@@ -80,10 +92,10 @@ func multiplyWinnings(hands []Hand) int {
 	return winnings
 }
 
-func parseHands(r io.Reader) []Hand {
+func parseHands(r io.Reader) ([]Hand, error) {
 	hands := []Hand{}
 
-	utils.ExecutePerLine(r, func(line string) error {
+	err := utils.ForEachLine(r, func(line string) error {
 		cards, wager, _ := strings.Cut(line, " ")
 
 		wagerInt, err := strconv.Atoi(wager)
@@ -93,7 +105,11 @@ func parseHands(r io.Reader) []Hand {
 
 		cardsSlice := []Card{}
 		for _, c := range cards {
-			cardsSlice = append(cardsSlice, cardFromRune(c))
+			card, err := cardFromRune(c)
+			if err != nil {
+				return err
+			}
+			cardsSlice = append(cardsSlice, card)
 		}
 
 		hands = append(hands, Hand{
@@ -105,6 +121,9 @@ func parseHands(r io.Reader) []Hand {
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return hands
+	return hands, nil
 }
@@ -0,0 +1,247 @@
+package day08
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+var (
+	ErrNoDirections = errors.New("no directions")
+	ErrBadNode      = errors.New("bad node")
+	ErrUnknownNode  = errors.New("unknown node")
+	ErrBadDirection = errors.New("bad direction")
+	ErrNoPathToZZZ  = errors.New("can't get from AAA to ZZZ")
+	ErrNoZCycle     = errors.New("ghost doesn't keep coming back to a Z node on a single cycle")
+)
+
+type Solver struct {
+	directions string
+	maps       Maps
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.directions, s.maps, err = parseMaps(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	steps, err := s.maps.stepsToZZZ(s.directions)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(steps), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	steps, err := s.maps.stepsToAllZs(s.directions)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(steps), nil
+}
+
+type Node struct {
+	Left  string
+	Right string
+}
+
+type Maps map[string]Node
+
+func (m Maps) stepsToZZZ(directions string) (int, error) {
+	if len(directions) == 0 {
+		return 0, ErrNoDirections
+	}
+	for _, node := range []string{"AAA", "ZZZ"} {
+		if _, ok := m[node]; !ok {
+			return 0, fmt.Errorf("%w: %q", ErrUnknownNode, node)
+		}
+	}
+
+	// once it's been through every node at every point in the directions, it's only going in circles
+	curNode := "AAA"
+	for steps := 0; steps < len(m)*len(directions); steps++ {
+		curNode = m.getNextNode(curNode, rune(directions[steps%len(directions)]))
+		if _, ok := m[curNode]; !ok {
+			return 0, fmt.Errorf("%w: %q", ErrUnknownNode, curNode)
+		}
+
+		if curNode == "ZZZ" {
+			return steps + 1, nil
+		}
+	}
+
+	return 0, ErrNoPathToZZZ
+}
+
+// stepsToAllZs finds when every ghost is on a node ending in Z at once. Each ghost reaches a Z
+// node for the first time after some offset and then keeps coming back to one every period steps,
+// so the answer is the first step that lines up with all of them.
+func (m Maps) stepsToAllZs(directions string) (int, error) {
+	offsets := []int{}
+	periods := []int{}
+	maxOffset := 0
+
+	// start from all nodes that end in A
+	for nodeName := range m {
+		if nodeName[2] != 'A' {
+			continue
+		}
+
+		offset, period, err := m.zCycle(nodeName, directions)
+		if err != nil {
+			return 0, err
+		}
+		offsets = append(offsets, offset)
+		periods = append(periods, period)
+		maxOffset = max(maxOffset, offset)
+	}
+
+	steps, period, err := utils.ChineseRemainder(offsets, periods)
+	if err != nil {
+		return 0, err
+	}
+
+	// every ghost has to have gotten to its cycle first
+	if steps < maxOffset {
+		steps += (maxOffset - steps + period - 1) / period * period
+	}
+
+	return steps, nil
+}
+
+// zCycle walks from start until it's back in a state it's been in before, on the same node at the same
+// point in the directions, so it has to keep looping from there. The loop needs to get to nodes ending in
+// Z at evenly spaced steps, which gives the steps to get to the first one and the steps between each
+// visit after.
+func (m Maps) zCycle(start, directions string) (offset, period int, err error) {
+	if len(directions) == 0 {
+		return 0, 0, ErrNoDirections
+	}
+
+	type walkState struct {
+		node   string
+		dirIdx int
+	}
+	seen := map[walkState]int{}
+	zSteps := []int{}
+
+	// there are only so many states, so one has to repeat by the time it's been through all of them
+	curNode := start
+	for steps := 0; steps <= len(m)*len(directions); steps++ {
+		if _, ok := m[curNode]; !ok {
+			return 0, 0, fmt.Errorf("%w: %q", ErrUnknownNode, curNode)
+		}
+
+		state := walkState{node: curNode, dirIdx: steps % len(directions)}
+		if loopStart, ok := seen[state]; ok {
+			inLoop := utils.SliceFilter(zSteps, func(s int) bool { return s >= loopStart })
+			if len(inLoop) == 0 {
+				return 0, 0, fmt.Errorf("%w: %s never gets to one in its loop", ErrNoZCycle, start)
+			}
+
+			// the same Z node can come up more than once in the loop, at a different point in the
+			// directions, but they have to be evenly spaced for there to be a single period
+			loopLen := steps - loopStart
+			period := loopLen / len(inLoop)
+			for i, s := range inLoop {
+				if s != inLoop[0]+i*period || period*len(inLoop) != loopLen {
+					return 0, 0, fmt.Errorf("%w: %s gets to them at uneven steps %v", ErrNoZCycle, start, inLoop)
+				}
+			}
+			return inLoop[0], period, nil
+		}
+		seen[state] = steps
+
+		if strings.HasSuffix(curNode, "Z") {
+			zSteps = append(zSteps, steps)
+		}
+		curNode = m.getNextNode(curNode, rune(directions[state.dirIdx]))
+	}
+
+	// can't get here, since a state always repeats
+	return 0, 0, fmt.Errorf("%w: %s never loops", ErrNoZCycle, start)
+}
+
+func (m Maps) getNextNode(curNode string, dir rune) string {
+	switch dir {
+	case 'L':
+		return m[curNode].Left
+	case 'R':
+		return m[curNode].Right
+	}
+	// Parse only lets through L and R
+	panic("unknown direction: " + string(dir))
+}
+
+func parseMaps(r io.Reader) (string, Maps, error) {
+	directions := ""
+	maps := Maps{}
+
+	err := utils.ForEachLine(r, func(line string) error {
+		if len(line) == 0 {
+			return nil
+		}
+
+		if strings.Contains(line, "=") {
+			node, name, err := parseNode(line)
+			if err != nil {
+				return err
+			}
+			maps[name] = node
+
+			return nil
+		}
+
+		if directions != "" {
+			return fmt.Errorf("more than one line of directions")
+		}
+		directions = line
+
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	if directions == "" {
+		return "", nil, ErrNoDirections
+	}
+	if i := strings.IndexFunc(directions, func(r rune) bool { return r != 'L' && r != 'R' }); i >= 0 {
+		return "", nil, fmt.Errorf("%w: %q", ErrBadDirection, directions[i])
+	}
+
+	return directions, maps, nil
+}
+
+// parseNode reads a line like AAA = (BBB, CCC)
+func parseNode(line string) (Node, string, error) {
+	name, lr, ok := strings.Cut(line, " = ")
+	if !ok {
+		return Node{}, "", fmt.Errorf("%w: expected name = (left, right)", ErrBadNode)
+	}
+
+	lr, hasStart := strings.CutPrefix(lr, "(")
+	lr, hasEnd := strings.CutSuffix(lr, ")")
+	left, right, hasComma := strings.Cut(lr, ", ")
+	if !hasStart || !hasEnd || !hasComma {
+		return Node{}, "", fmt.Errorf("%w: expected name = (left, right)", ErrBadNode)
+	}
+
+	for _, n := range []string{name, left, right} {
+		if len(n) != 3 {
+			return Node{}, "", fmt.Errorf("%w: node names are 3 characters, got %q", ErrBadNode, n)
+		}
+	}
+
+	return Node{Left: left, Right: right}, name, nil
+}
@@ -0,0 +1,124 @@
+package day08
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(2),
+		},
+		{
+			Input: "testdata/example2.txt",
+			Part1: solver.Int(6),
+		},
+		{
+			Input: "testdata/example3.txt",
+			Part2: solver.Int(6),
+		},
+		{
+			// the ghosts get to their loops after different numbers of steps
+			Input: "testdata/example4.txt",
+			Part2: solver.Int(5),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example3.txt", 2)
+}
+
+func TestPart2BadLoops(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{
+			name:    "never gets to a Z",
+			input:   "L\n\n11A = (11B, 11B)\n11B = (11A, 11A)",
+			wantErr: ErrNoZCycle,
+		},
+		{
+			name:    "Zs at uneven steps",
+			input:   "L\n\n11A = (11Z, 11Z)\n11Z = (12Z, 12Z)\n12Z = (11B, 11B)\n11B = (11A, 11A)",
+			wantErr: ErrNoZCycle,
+		},
+		{
+			name:    "unknown node",
+			input:   "L\n\n11A = (11X, 11X)",
+			wantErr: ErrUnknownNode,
+		},
+	}
+
+	for _, tt := range tests {
+		s := New()
+		if err := s.Parse(strings.NewReader(tt.input)); err != nil {
+			t.Fatalf("%s: failed to parse: %v", tt.name, err)
+		}
+		if _, err := s.Part2(); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestParseBadInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"unclosed node", "L\n\nAAA = (BBB", ErrBadNode},
+		{"no spaces around equals", "L\n\nAAA=(BBB, CCC)", ErrBadNode},
+		{"no comma", "L\n\nAAA = (BBB CCC)", ErrBadNode},
+		{"long name", "L\n\nAAAA = (BBB, CCC)", ErrBadNode},
+		{"no directions", "AAA = (BBB, CCC)", ErrNoDirections},
+		{"empty input", "", ErrNoDirections},
+	}
+
+	for _, tt := range tests {
+		if err := New().Parse(strings.NewReader(tt.input)); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestPart1BadPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"ZZZ unreachable", "L\n\nAAA = (BBB, BBB)\nBBB = (AAA, AAA)\nZZZ = (ZZZ, ZZZ)", ErrNoPathToZZZ},
+		{"no AAA", "L\n\nBBB = (ZZZ, ZZZ)\nZZZ = (ZZZ, ZZZ)", ErrUnknownNode},
+		{"no ZZZ", "L\n\nAAA = (BBB, BBB)\nBBB = (AAA, AAA)", ErrUnknownNode},
+		{"leads to unknown node", "L\n\nAAA = (XXX, XXX)\nZZZ = (ZZZ, ZZZ)", ErrUnknownNode},
+	}
+
+	for _, tt := range tests {
+		s := New()
+		if err := s.Parse(strings.NewReader(tt.input)); err != nil {
+			t.Fatalf("%s: failed to parse: %v", tt.name, err)
+		}
+		if _, err := s.Part1(); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestParseBadDirection(t *testing.T) {
+	if err := New().Parse(strings.NewReader("LRX\n\nAAA = (ZZZ, ZZZ)")); !errors.Is(err, ErrBadDirection) {
+		t.Errorf("expected %v, got %v", ErrBadDirection, err)
+	}
+}
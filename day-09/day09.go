@@ -0,0 +1,84 @@
+package day09
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+type Solver struct {
+	readings []OasisReading
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.readings, err = parseOasis(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	sum := 0
+	for _, r := range s.readings {
+		sum += r.GetNextNumber()
+	}
+	return solver.Int(sum), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	sum := 0
+	for _, r := range s.readings {
+		sum += r.GetPrevNumber()
+	}
+	return solver.Int(sum), nil
+}
+
+type OasisReading []int
+
+func (o OasisReading) GetNextNumber() int {
+	return o.nevilleInterpolation(len(o))
+}
+
+func (o OasisReading) GetPrevNumber() int {
+	return o.nevilleInterpolation(-1)
+}
+
+func (o OasisReading) nevilleInterpolation(x int) int {
+	xs := make([]int, len(o))
+	for i := range o {
+		xs[i] = i
+	}
+	return utils.NevilleInterpolation(xs, o, x)
+}
+
+func parseOasis(r io.Reader) ([]OasisReading, error) {
+	readings := []OasisReading{}
+
+	err := utils.ForEachLine(r, func(line string) error {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			// there's no history to extrapolate from a blank line
+			return nil
+		}
+
+		reading, err := utils.StrSliceToIntSlice(fields)
+		if err != nil {
+			return fmt.Errorf("failed to parse line %q: %w", line, err)
+		}
+
+		readings = append(readings, reading)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return readings, nil
+}
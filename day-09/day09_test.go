@@ -0,0 +1,44 @@
+package day09
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(114),
+			Part2: solver.Int(2),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
+
+func TestBlankLines(t *testing.T) {
+	s := New()
+	if err := s.Parse(strings.NewReader("\n\n0 3 6 9 12 15\n\n")); err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	for part, want := range map[int]solver.Answer{1: solver.Int(18), 2: solver.Int(-3)} {
+		got, err := solver.SolvePart(s, part)
+		if err != nil {
+			t.Fatalf("part %d returned error: %v", part, err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("part %d = %s, want %s", part, got, want)
+		}
+	}
+}
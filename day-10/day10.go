@@ -0,0 +1,196 @@
+package day10
+
+import (
+	"errors"
+	"io"
+	"slices"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+var (
+	ErrNoLoop = errors.New("no loop through the starting position")
+)
+
+type Solver struct {
+	loop *Node
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	grid, sLocation, err := parseGrid(r)
+	if err != nil {
+		return err
+	}
+
+	s.loop = findLoop(grid, sLocation)
+	if len(s.loop.attachedNodes) != 2 {
+		return ErrNoLoop
+	}
+
+	return nil
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	return solver.Int(s.loop.furthestFromStart()), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	return solver.Int(s.loop.polygon().InteriorPoints()), nil
+}
+
+type Node struct {
+	attachedNodes []*Node
+	coor          utils.Coordinate
+}
+
+func NewNode(coor utils.Coordinate) *Node {
+	return &Node{
+		attachedNodes: []*Node{},
+		coor:          coor,
+	}
+}
+
+func (n *Node) nextNode(last *Node) *Node {
+	for _, attached := range n.attachedNodes {
+		if attached != last {
+			return attached
+		}
+	}
+	// should never get here
+	return nil
+}
+
+func (n *Node) furthestFromStart() int {
+	lastLeft, lastRight := n, n
+	left, right := n.attachedNodes[0], n.attachedNodes[1]
+
+	steps := 1
+	for left != right {
+		newLeft := left.nextNode(lastLeft)
+		lastLeft, left = left, newLeft
+
+		newRight := right.nextNode(lastRight)
+		lastRight, right = right, newRight
+
+		steps++
+	}
+
+	return steps
+}
+
+// polygon is the shape the loop makes, with a vertex at every pipe in it
+func (n *Node) polygon() utils.Polygon {
+	p := utils.NewPolygon(n.coor)
+
+	lastNode, curNode := n, n.attachedNodes[0]
+	for curNode != n {
+		p = append(p, curNode.coor)
+
+		newNode := curNode.nextNode(lastNode)
+		lastNode, curNode = curNode, newNode
+	}
+
+	return p
+}
+
+var PossibleNextPipes = map[utils.Direction][]rune{
+	utils.UP:    {'F', '7', '|'},
+	utils.DOWN:  {'L', 'J', '|'},
+	utils.LEFT:  {'F', 'L', '-'},
+	utils.RIGHT: {'7', 'J', '-'},
+}
+
+var PipeAttachments = map[rune][]utils.Direction{
+	'|': {utils.UP, utils.DOWN},
+	'-': {utils.LEFT, utils.RIGHT},
+	'L': {utils.UP, utils.RIGHT},
+	'J': {utils.LEFT, utils.UP},
+	'7': {utils.LEFT, utils.DOWN},
+	'F': {utils.RIGHT, utils.DOWN},
+	'S': {utils.UP, utils.DOWN, utils.LEFT, utils.RIGHT},
+}
+
+func findLoop(grid Grid, startingLocation utils.Coordinate) *Node {
+	sNode := NewNode(startingLocation)
+	foundLoop := false
+
+	var dfs func(curNode *Node, coor utils.Coordinate, lastCoor utils.Coordinate) bool
+	dfs = func(curNode *Node, coor utils.Coordinate, lastCoor utils.Coordinate) bool {
+		if foundLoop {
+			return false
+		}
+
+		gridNode := grid.get(coor)
+
+		for _, direction := range PipeAttachments[gridNode.r] {
+			newCoor := coor.MoveDir(direction)
+
+			if !grid.InBounds(newCoor) {
+				continue
+			}
+			if newCoor == lastCoor {
+				// don't go backwards
+				continue
+			}
+
+			neighbor := grid.get(newCoor)
+
+			if neighbor.r == 'S' {
+				foundLoop = true
+				curNode.attachedNodes = append(curNode.attachedNodes, sNode)
+				sNode.attachedNodes = append(sNode.attachedNodes, curNode)
+				return true
+			}
+
+			if slices.Contains(PossibleNextPipes[direction], neighbor.r) {
+				newNode := NewNode(newCoor)
+				foundS := dfs(newNode, newCoor, coor)
+
+				if foundS {
+					curNode.attachedNodes = append(curNode.attachedNodes, newNode)
+					newNode.attachedNodes = append(newNode.attachedNodes, curNode)
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	dfs(sNode, startingLocation, utils.NewCoordinate(-1, -1))
+
+	return sNode
+}
+
+type Grid struct {
+	utils.Grid[GridNode]
+}
+
+func (g Grid) get(coor utils.Coordinate) *GridNode {
+	return g.Ptr(coor)
+}
+
+type GridNode struct {
+	r rune
+}
+
+func parseGrid(r io.Reader) (Grid, utils.Coordinate, error) {
+	g, err := utils.ParseGrid(r, func(r rune) (GridNode, error) {
+		return GridNode{r: r}, nil
+	})
+	if err != nil {
+		return Grid{}, utils.Coordinate{}, err
+	}
+
+	coor, ok := g.Find(func(gNode GridNode) bool { return gNode.r == 'S' })
+	if !ok {
+		return Grid{}, utils.Coordinate{}, errors.New("no starting position in grid")
+	}
+
+	return Grid{g}, coor, nil
+}
@@ -0,0 +1,58 @@
+package day10
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(4),
+		},
+		{
+			Input: "testdata/example2.txt",
+			Part1: solver.Int(8),
+		},
+		{
+			Input: "testdata/example3.txt",
+			Part2: solver.Int(4),
+		},
+		{
+			Input: "testdata/example4.txt",
+			Part2: solver.Int(8),
+		},
+		{
+			Input: "testdata/example5.txt",
+			Part2: solver.Int(10),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example2.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example5.txt", 2)
+}
+
+func TestParseNoLoop(t *testing.T) {
+	inputs := []string{
+		// S doesn't connect to anything
+		".....\n.S-..\n.....",
+		// S connects to a pipe that leads nowhere
+		".....\n.S-7.\n...|.\n.....",
+	}
+
+	for _, input := range inputs {
+		if err := New().Parse(strings.NewReader(input)); !errors.Is(err, ErrNoLoop) {
+			t.Errorf("expected ErrNoLoop for %q, got %v", input, err)
+		}
+	}
+}
@@ -1,9 +1,10 @@
-package main
+package day11
 
 import (
-	"fmt"
 	"io"
+	"slices"
 
+	"github.com/mellena1/advent-of-code-2023/solver"
 	"github.com/mellena1/advent-of-code-2023/utils"
 )
 
@@ -12,19 +13,32 @@ const (
 	SPACE  = '.'
 )
 
-func main() {
-	f := utils.ReadFile("input.txt")
-	defer f.Close()
+type Solver struct {
+	grid Grid
+	// how many times larger each empty row and column becomes in part two
+	expansion int
+}
 
-	grid := parseGrid(f)
+func New() solver.Solver {
+	return &Solver{
+		expansion: 1_000_000,
+	}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.grid, err = parseGrid(r)
+	return err
+}
 
-	// part one
-	galaxies := grid.findGalaxies(1)
-	fmt.Printf("Part one solution: %d\n", sumAllStepsToGalaxies(galaxies))
+func (s *Solver) Part1() (solver.Answer, error) {
+	galaxies := s.grid.findGalaxies(1)
+	return solver.Int(sumAllStepsToGalaxies(galaxies)), nil
+}
 
-	// part two
-	galaxiesP2 := grid.findGalaxies(999_999)
-	fmt.Printf("Part two solution: %d\n", sumAllStepsToGalaxies(galaxiesP2))
+func (s *Solver) Part2() (solver.Answer, error) {
+	galaxies := s.grid.findGalaxies(s.expansion - 1)
+	return solver.Int(sumAllStepsToGalaxies(galaxies)), nil
 }
 
 func sumAllStepsToGalaxies(galaxies []Galaxy) int {
@@ -45,40 +59,26 @@ func (g Galaxy) stepsToOtherGalaxy(g2 Galaxy) int {
 	return g.Coor.StepsToCoordinate(g2.Coor)
 }
 
-type Grid [][]utils.Char
-
-func (g Grid) String() string {
-	s := ""
-	for i := 0; i < len(g); i++ {
-		for j := 0; j < len(g[i]); j++ {
-			s += string(g[i][j])
-		}
-		s += "\n"
-	}
-	// rm extra newline
-	return s[:len(s)-1]
+type Grid struct {
+	utils.Grid[utils.Char]
 }
 
 func (g Grid) emptyRowsAndColIdxs() ([]int, []int) {
-	// assumes that the grid is a square
+	isEmpty := func(line []utils.Char) bool {
+		return !slices.Contains(line, GALAXY)
+	}
+
 	emptyRowIdxs := []int{}
-	emptyColIdxs := []int{}
-	for i := 0; i < len(g); i++ {
-		emptyRow := true
-		emptyCol := true
-		for j := 0; j < len(g[0]); j++ {
-			if g[i][j] == GALAXY {
-				emptyRow = false
-			}
-			if g[j][i] == GALAXY {
-				emptyCol = false
-			}
-		}
-		if emptyRow {
-			emptyRowIdxs = append(emptyRowIdxs, i)
+	for y := 0; y < g.Height(); y++ {
+		if isEmpty(g.Row(y)) {
+			emptyRowIdxs = append(emptyRowIdxs, y)
 		}
-		if emptyCol {
-			emptyColIdxs = append(emptyColIdxs, i)
+	}
+
+	emptyColIdxs := []int{}
+	for x := 0; x < g.Width(); x++ {
+		if isEmpty(g.Col(x)) {
+			emptyColIdxs = append(emptyColIdxs, x)
 		}
 	}
 
@@ -89,7 +89,7 @@ func (g Grid) findGalaxies(numSpaceToAdd int) []Galaxy {
 	emptyRowIdxs, emptyColIdxs := g.emptyRowsAndColIdxs()
 
 	galaxies := []Galaxy{}
-	for i, row := range g {
+	for i, row := range g.Grid {
 		for j, val := range row {
 			if val == GALAXY {
 				// calc offsets for space expansion
@@ -117,13 +117,7 @@ func (g Grid) findGalaxies(numSpaceToAdd int) []Galaxy {
 	return galaxies
 }
 
-func parseGrid(r io.Reader) Grid {
-	grid := Grid{}
-
-	utils.ExecutePerLine(r, func(line string) error {
-		grid = append(grid, []utils.Char(line))
-		return nil
-	})
-
-	return grid
+func parseGrid(r io.Reader) (Grid, error) {
+	g, err := utils.ParseCharGrid(r)
+	return Grid{g}, err
 }
@@ -0,0 +1,41 @@
+package day11
+
+import (
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(374),
+			Part2: solver.Int(82000210),
+		},
+	})
+}
+
+func TestExpansion(t *testing.T) {
+	tests := []struct {
+		expansion int
+		want      int
+	}{
+		{expansion: 10, want: 1030},
+		{expansion: 100, want: 8410},
+	}
+
+	for _, tt := range tests {
+		s := &Solver{expansion: tt.expansion}
+		solvertest.CheckPart(t, s, "testdata/example.txt", 2, solver.Int(tt.want))
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
@@ -1,4 +1,4 @@
-package main
+package day12
 
 import (
 	"fmt"
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/mellena1/advent-of-code-2023/solver"
 	"github.com/mellena1/advent-of-code-2023/utils"
 )
 
@@ -22,23 +23,34 @@ const (
 	QUESTION SpringState = '?'
 )
 
-func main() {
-	f := utils.ReadFile("input.txt")
-	defer f.Close()
+type Solver struct {
+	lines []LineOfSprings
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
 
-	lines := parseLines(f)
-	partOne := 0
-	for _, l := range lines {
-		partOne += l.PossibleArrangements()
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.lines, err = parseLines(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	sum := 0
+	for _, l := range s.lines {
+		sum += l.PossibleArrangements()
 	}
-	fmt.Printf("Part one solution: %d\n", partOne)
+	return solver.Int(sum), nil
+}
 
-	partTwo := 0
-	for _, l := range lines {
-		s := l.Unfold().PossibleArrangements()
-		partTwo += s
+func (s *Solver) Part2() (solver.Answer, error) {
+	sum := 0
+	for _, l := range s.lines {
+		sum += l.Unfold().PossibleArrangements()
 	}
-	fmt.Printf("Part two solution: %d\n", partTwo)
+	return solver.Int(sum), nil
 }
 
 type LineOfSprings struct {
@@ -145,9 +157,9 @@ func bruteForceCombos(springs []SpringState, groups []int, cache map[string]int)
 	return total
 }
 
-func parseLines(r io.Reader) []LineOfSprings {
+func parseLines(r io.Reader) ([]LineOfSprings, error) {
 	lines := []LineOfSprings{}
-	utils.ExecutePerLine(r, func(line string) error {
+	err := utils.ForEachLine(r, func(line string) error {
 		springs, groups, _ := strings.Cut(line, " ")
 
 		groupsInts, err := utils.StrSliceToIntSlice(strings.FieldsFunc(groups, func(r rune) bool {
@@ -167,5 +179,8 @@ func parseLines(r io.Reader) []LineOfSprings {
 
 		return nil
 	})
-	return lines
+	if err != nil {
+		return nil, err
+	}
+	return lines, nil
 }
@@ -0,0 +1,141 @@
+package day13
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+const (
+	ASH  = '.'
+	ROCK = '#'
+)
+
+type Solver struct {
+	patterns []Pattern
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.patterns, err = parsePatterns(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	sum := 0
+	for _, p := range s.patterns {
+		sum += p.VerticalReflection()
+		sum += (p.HorizontalReflection() * 100)
+	}
+	return solver.Int(sum), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	sum := 0
+	for _, p := range s.patterns {
+		sum += p.VerticalReflectionWithSmudge()
+		sum += (p.HorizontalReflectionWithSmudge() * 100)
+	}
+	return solver.Int(sum), nil
+}
+
+type Pattern struct {
+	utils.Grid[utils.Char]
+}
+
+func (p Pattern) HorizontalReflection() int {
+	numRows := p.Height()
+	for mirrorRow := 0; mirrorRow < numRows-1; mirrorRow++ {
+		isMirrored := true
+		numAbove := mirrorRow + 1
+		numBelow := numRows - mirrorRow - 1
+		numToLookAt := min(numAbove, numBelow)
+		for i := 0; i < numToLookAt; i++ {
+			if !slices.Equal(p.Grid[mirrorRow-i], p.Grid[mirrorRow+i+1]) {
+				isMirrored = false
+				break
+			}
+		}
+		if isMirrored {
+			return numAbove
+		}
+	}
+	return 0
+}
+
+func (p Pattern) HorizontalReflectionWithSmudge() int {
+	numRows := p.Height()
+	for mirrorRow := 0; mirrorRow < numRows-1; mirrorRow++ {
+		numWrong := 0
+		numAbove := mirrorRow + 1
+		numBelow := numRows - mirrorRow - 1
+		numToLookAt := min(numAbove, numBelow)
+		for i := 0; i < numToLookAt; i++ {
+			numWrong += numDifferentInRows(p.Grid[mirrorRow-i], p.Grid[mirrorRow+i+1])
+			if numWrong > 1 {
+				break
+			}
+		}
+		if numWrong == 1 {
+			return numAbove
+		}
+	}
+	return 0
+}
+
+// VerticalReflection is a horizontal reflection of the transposed pattern
+func (p Pattern) VerticalReflection() int {
+	return Pattern{p.Transpose()}.HorizontalReflection()
+}
+
+func (p Pattern) VerticalReflectionWithSmudge() int {
+	return Pattern{p.Transpose()}.HorizontalReflectionWithSmudge()
+}
+
+func numDifferentInRows(r1, r2 []utils.Char) int {
+	numDiff := 0
+	for i, c := range r1 {
+		if c != r2[i] {
+			numDiff++
+		}
+	}
+	return numDiff
+}
+
+func parsePatterns(r io.Reader) ([]Pattern, error) {
+	patterns := []Pattern{}
+
+	curPattern := Pattern{}
+	err := utils.ForEachLine(r, func(line string) error {
+		if line == "" {
+			if curPattern.Height() > 0 {
+				patterns = append(patterns, curPattern)
+			}
+			curPattern = Pattern{}
+			return nil
+		}
+
+		row := []utils.Char(line)
+		if curPattern.Height() > 0 && len(row) != curPattern.Width() {
+			return fmt.Errorf("row has length %d, expected %d", len(row), curPattern.Width())
+		}
+
+		curPattern.Grid = append(curPattern.Grid, row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if curPattern.Height() > 0 {
+		patterns = append(patterns, curPattern)
+	}
+
+	return patterns, nil
+}
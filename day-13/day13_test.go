@@ -0,0 +1,39 @@
+package day13
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(405),
+			Part2: solver.Int(400),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
+
+func TestParseRaggedPattern(t *testing.T) {
+	input := "#.#\n.#.\n\n#.#.\n.#.\n#.#."
+
+	err := New().Parse(strings.NewReader(input))
+	var parseErr *utils.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 5 {
+		t.Errorf("expected a *utils.ParseError on line 5, got %v", err)
+	}
+}
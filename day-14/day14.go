@@ -0,0 +1,163 @@
+package day14
+
+import (
+	"io"
+	"slices"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+const (
+	ROCK    = 'O'
+	BLOCKER = '#'
+	EMPTY   = '.'
+)
+
+type Solver struct {
+	grid Grid
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.grid, err = parseGrid(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	grid := s.grid.copy().shiftNorth()
+	return solver.Int(grid.calcTotalLoad()), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	grid := s.grid.copy()
+	cache := GridCache{}
+	numCycles := 1_000_000_000
+	for i := 0; i < numCycles; i++ {
+		prevLenOfCache := len(cache)
+
+		grid = grid.cycle(cache)
+
+		// we hit a cycle
+		if len(cache) == prevLenOfCache {
+			break
+		}
+	}
+
+	preCycle, cycle := cache.getGraphOfCycle(s.grid)
+
+	if numCycles < len(preCycle) {
+		return solver.Int(preCycle[numCycles].calcTotalLoad()), nil
+	}
+
+	idx := (numCycles - len(preCycle)) % len(cycle)
+	return solver.Int(cycle[idx].calcTotalLoad()), nil
+}
+
+type GridCache map[string]Grid
+
+func (c GridCache) getGraphOfCycle(firstGrid Grid) ([]Grid, []Grid) {
+	allPossibleGrids := []Grid{firstGrid}
+
+	gridCompare := func(g Grid) func(g2 Grid) bool {
+		gridString := g.String()
+		return func(g2 Grid) bool {
+			return gridString == g2.String()
+		}
+	}
+
+	for _, v := range c {
+		if slices.ContainsFunc(allPossibleGrids, gridCompare(v)) {
+			continue
+		}
+
+		allPossibleGrids = append(allPossibleGrids, v)
+	}
+
+	orderedGrids := []Grid{}
+	curGrid := firstGrid
+
+	for !slices.ContainsFunc(orderedGrids, gridCompare(curGrid)) {
+		orderedGrids = append(orderedGrids, curGrid)
+
+		nextGrid := c[curGrid.String()]
+		nextGridDedupIdx := slices.IndexFunc(allPossibleGrids, gridCompare(nextGrid))
+		nextGrid = allPossibleGrids[nextGridDedupIdx]
+
+		curGrid = nextGrid
+	}
+
+	// curGrid is now where the cycle is
+	cycleIdx := slices.IndexFunc(orderedGrids, gridCompare(curGrid))
+
+	// return everything before the cycle, and then the cycle
+	return orderedGrids[:cycleIdx], orderedGrids[cycleIdx:]
+}
+
+type Grid struct {
+	utils.Grid[utils.Char]
+}
+
+func (g Grid) copy() Grid {
+	return Grid{g.Clone()}
+}
+
+func (g Grid) cycle(cache GridCache) Grid {
+	strGrid := g.String()
+	if cachedG, ok := cache[strGrid]; ok {
+		return cachedG
+	}
+
+	// tilting north and then turning the platform clockwise four times
+	// tilts it north, west, south and then east
+	newGrid := g.copy()
+	for i := 0; i < 4; i++ {
+		newGrid = Grid{newGrid.shiftNorth().RotateClockwise()}
+	}
+
+	cache[strGrid] = newGrid
+
+	return newGrid
+}
+
+func (g Grid) shiftNorth() Grid {
+	for i := 1; i < g.Height(); i++ {
+		for j, c := range g.Grid[i] {
+			if c != ROCK {
+				continue
+			}
+
+			for y := i - 1; y >= 0; y-- {
+				if g.Grid[y][j] == EMPTY {
+					g.Grid[y][j] = ROCK
+					g.Grid[y+1][j] = EMPTY
+				} else {
+					break
+				}
+			}
+		}
+	}
+
+	return g
+}
+
+func (g Grid) calcTotalLoad() int {
+	load := 0
+	for i, row := range g.Grid {
+		for _, c := range row {
+			if c == ROCK {
+				load += g.Height() - i
+			}
+		}
+	}
+	return load
+}
+
+func parseGrid(r io.Reader) (Grid, error) {
+	g, err := utils.ParseCharGrid(r)
+	return Grid{g}, err
+}
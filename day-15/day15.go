@@ -1,12 +1,14 @@
-package main
+package day15
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/mellena1/advent-of-code-2023/solver"
 	"github.com/mellena1/advent-of-code-2023/utils"
 )
 
@@ -15,14 +17,34 @@ const (
 	DASH  = '-'
 )
 
-func main() {
-	f := utils.ReadFile("input.txt")
-	defer f.Close()
+var (
+	ErrBadStep = errors.New("bad step")
+)
+
+type Solver struct {
+	seq InitSequence
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.seq, err = parseInitSequence(r)
+	return err
+}
 
-	seq := parseInitSequence(f)
-	fmt.Printf("Part one solution: %d\n", seq.SumOfHashes())
+func (s *Solver) Part1() (solver.Answer, error) {
+	return solver.Int(s.seq.SumOfHashes()), nil
+}
 
-	fmt.Printf("Part two solution: %d\n", seq.FocusingPower())
+func (s *Solver) Part2() (solver.Answer, error) {
+	power, err := s.seq.FocusingPower()
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(power), nil
 }
 
 type Step []utils.Char
@@ -45,29 +67,32 @@ type StepLabelAndAction struct {
 	FocalLength int
 }
 
-func (s Step) LabelAndAction() StepLabelAndAction {
+func (s Step) LabelAndAction() (StepLabelAndAction, error) {
 	strS := string(s)
-	strEqual := string([]rune{EQUAL})
-	if strings.Contains(strS, strEqual) {
-		split := strings.Split(strS, strEqual)
-		focalLength, err := strconv.Atoi(split[1])
+	if label, focalLengthStr, ok := strings.Cut(strS, string([]rune{EQUAL})); ok {
+		focalLength, err := strconv.Atoi(focalLengthStr)
 		if err != nil {
-			panic("bad focal length")
+			return StepLabelAndAction{}, fmt.Errorf("%w: bad focal length in %q: %w", ErrBadStep, strS, err)
+		}
+		if label == "" {
+			return StepLabelAndAction{}, fmt.Errorf("%w: no label in %q", ErrBadStep, strS)
 		}
 		return StepLabelAndAction{
-			Label:       Step(split[0]),
+			Label:       Step(label),
 			Action:      EQUAL,
 			FocalLength: focalLength,
-		}
+		}, nil
 	}
 
-	strDash := string([]rune{DASH})
-	label, _ := strings.CutSuffix(strS, strDash)
+	label, ok := strings.CutSuffix(strS, string([]rune{DASH}))
+	if !ok || label == "" {
+		return StepLabelAndAction{}, fmt.Errorf("%w: expected label=n or label-, got %q", ErrBadStep, strS)
+	}
 	return StepLabelAndAction{
 		Label:       Step(label),
 		Action:      DASH,
 		FocalLength: -1,
-	}
+	}, nil
 }
 
 type InitSequence []Step
@@ -123,11 +148,14 @@ func (b Boxes) RemoveLabel(boxIdx int, label string) {
 	b[boxIdx] = append(b[boxIdx][:idx], b[boxIdx][idx+1:]...)
 }
 
-func (seq InitSequence) FocusingPower() int {
+func (seq InitSequence) FocusingPower() (int, error) {
 	boxes := NewBoxes()
 
 	for _, step := range seq {
-		labelAndAction := step.LabelAndAction()
+		labelAndAction, err := step.LabelAndAction()
+		if err != nil {
+			return 0, err
+		}
 		hash := labelAndAction.Label.Hash()
 
 		switch labelAndAction.Action {
@@ -149,20 +177,26 @@ func (seq InitSequence) FocusingPower() int {
 		}
 	}
 
-	return focusingPower
+	return focusingPower, nil
 }
 
-func parseInitSequence(r io.Reader) InitSequence {
+func parseInitSequence(r io.Reader) (InitSequence, error) {
 	seq := InitSequence{}
 
-	utils.ExecutePerLine(r, func(line string) error {
+	err := utils.ForEachLine(r, func(line string) error {
 		commaSep := strings.Split(line, ",")
 		for _, step := range commaSep {
+			if _, err := Step(step).LabelAndAction(); err != nil {
+				return err
+			}
 			seq = append(seq, Step(step))
 		}
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return seq
+	return seq, nil
 }
@@ -0,0 +1,48 @@
+package day15
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(1320),
+			Part2: solver.Int(145),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
+
+func TestParseBadInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"bad focal length", "rn=1,cm=x"},
+		{"no focal length", "rn="},
+		{"no label", "=1"},
+		{"no action", "rn=1,cm"},
+		{"empty step", "rn=1,,cm-"},
+	}
+
+	for _, tt := range tests {
+		err := New().Parse(strings.NewReader(tt.input))
+		if !errors.Is(err, ErrBadStep) {
+			t.Errorf("%s: expected %v, got %v", tt.name, ErrBadStep, err)
+		}
+	}
+}
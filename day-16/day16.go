@@ -0,0 +1,138 @@
+package day16
+
+import (
+	"io"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+const (
+	VERT_SPLITTER    utils.Char = '|'
+	HORIZ_SPLITTER   utils.Char = '-'
+	EMPTY            utils.Char = '.'
+	CLOCKWISE_MIRROR utils.Char = '/'
+	COUNTER_MIRROR   utils.Char = '\\'
+)
+
+type Solver struct {
+	grid Grid
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.grid, err = parseGrid(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	return solver.Int(s.grid.CountEnergized(utils.NewCoordinate(0, 0), utils.RIGHT)), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	return solver.Int(s.grid.MaxEnergizedFromAllStartingPoints()), nil
+}
+
+var SpaceInteractions = map[utils.Char]map[utils.Direction][]utils.Direction{
+	VERT_SPLITTER: {
+		utils.RIGHT: {utils.UP, utils.DOWN},
+		utils.LEFT:  {utils.UP, utils.DOWN},
+		utils.UP:    {utils.UP},
+		utils.DOWN:  {utils.DOWN},
+	},
+	HORIZ_SPLITTER: {
+		utils.RIGHT: {utils.RIGHT},
+		utils.LEFT:  {utils.LEFT},
+		utils.UP:    {utils.LEFT, utils.RIGHT},
+		utils.DOWN:  {utils.LEFT, utils.RIGHT},
+	},
+	EMPTY: {
+		utils.RIGHT: {utils.RIGHT},
+		utils.LEFT:  {utils.LEFT},
+		utils.UP:    {utils.UP},
+		utils.DOWN:  {utils.DOWN},
+	},
+	CLOCKWISE_MIRROR: {
+		utils.RIGHT: {utils.UP},
+		utils.LEFT:  {utils.DOWN},
+		utils.UP:    {utils.RIGHT},
+		utils.DOWN:  {utils.LEFT},
+	},
+	COUNTER_MIRROR: {
+		utils.RIGHT: {utils.DOWN},
+		utils.LEFT:  {utils.UP},
+		utils.UP:    {utils.LEFT},
+		utils.DOWN:  {utils.RIGHT},
+	},
+}
+
+type Grid struct {
+	utils.Grid[utils.Char]
+}
+
+func (g Grid) CountEnergized(startingCoor utils.Coordinate, startingDirection utils.Direction) int {
+	energized := utils.NewGrid(g.Width(), g.Height(), false)
+
+	type CoorAndDirection struct {
+		Coor utils.Coordinate
+		Dir  utils.Direction
+	}
+
+	var traverse func(coor utils.Coordinate, direction utils.Direction, touched map[CoorAndDirection]bool)
+	traverse = func(coor utils.Coordinate, direction utils.Direction, touched map[CoorAndDirection]bool) {
+		if !g.InBounds(coor) {
+			return
+		}
+
+		// avoid cycles
+		coorAndDir := CoorAndDirection{Coor: coor, Dir: direction}
+		if _, ok := touched[coorAndDir]; ok {
+			return
+		}
+		touched[coorAndDir] = true
+
+		energized.Set(coor, true)
+
+		newDirections := SpaceInteractions[g.Get(coor)][direction]
+		for _, newDir := range newDirections {
+			traverse(coor.MoveDir(newDir), newDir, touched)
+		}
+	}
+
+	traverse(startingCoor, startingDirection, map[CoorAndDirection]bool{})
+
+	return len(energized.FindAll(func(isEnergized bool) bool { return isEnergized }))
+}
+
+func (g Grid) MaxEnergizedFromAllStartingPoints() int {
+	maxConfig := 0
+
+	for x := 0; x < g.Width(); x++ {
+		if count := g.CountEnergized(utils.NewCoordinate(x, 0), utils.DOWN); count > maxConfig {
+			maxConfig = count
+		}
+		if count := g.CountEnergized(utils.NewCoordinate(x, g.Height()-1), utils.UP); count > maxConfig {
+			maxConfig = count
+		}
+	}
+
+	for y := 0; y < g.Height(); y++ {
+		if count := g.CountEnergized(utils.NewCoordinate(0, y), utils.RIGHT); count > maxConfig {
+			maxConfig = count
+		}
+		if count := g.CountEnergized(utils.NewCoordinate(g.Width()-1, y), utils.LEFT); count > maxConfig {
+			maxConfig = count
+		}
+	}
+
+	return maxConfig
+}
+
+func parseGrid(r io.Reader) (Grid, error) {
+	g, err := utils.ParseCharGrid(r)
+	return Grid{g}, err
+}
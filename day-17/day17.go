@@ -0,0 +1,134 @@
+package day17
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+type Solver struct {
+	grid Grid
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.grid, err = parseGrid(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	return solver.Int(s.grid.MinHeatLoss()), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	return solver.Int(s.grid.MinHeatLossPart2()), nil
+}
+
+type Grid struct {
+	utils.Grid[int]
+}
+
+type crucibleState struct {
+	Coor     utils.Coordinate
+	Dir      utils.Direction
+	numInDir int
+}
+
+// crucibleGraph finds the moves a crucible can make on demand, rather than building every
+// possible state up front. It has to move at least minRun blocks before it can turn or stop,
+// and can't move more than maxRun blocks in a row.
+func (g Grid) crucibleGraph(minRun, maxRun int) utils.GraphFunc[crucibleState] {
+	return func(state crucibleState) []utils.Edge[crucibleState] {
+		// the starting state hasn't moved in any direction yet, so it can go anywhere. after that it
+		// can never turn around, only keep going or turn left or right.
+		nextDirs := utils.Cardinals()
+		if state.numInDir > 0 {
+			nextDirs = []utils.Direction{}
+			if state.numInDir < maxRun {
+				nextDirs = append(nextDirs, state.Dir)
+			}
+			if state.numInDir >= minRun {
+				nextDirs = append(nextDirs, state.Dir.TurnLeft(), state.Dir.TurnRight())
+			}
+		}
+
+		edges := []utils.Edge[crucibleState]{}
+		for _, nextDir := range nextDirs {
+			next := state.Coor.MoveDir(nextDir)
+			if !g.InBounds(next) {
+				continue
+			}
+
+			nextState := crucibleState{Coor: next, Dir: nextDir, numInDir: 1}
+			if nextDir == state.Dir {
+				nextState.numInDir = state.numInDir + 1
+			}
+
+			edges = append(edges, utils.Edge[crucibleState]{To: nextState, Weight: g.Get(next)})
+		}
+		return edges
+	}
+}
+
+func (g Grid) MinHeatLoss() int {
+	heatLoss, _ := g.minHeatLoss(1, 3)
+	return heatLoss
+}
+
+func (g Grid) MinHeatLossPart2() int {
+	heatLoss, _ := g.minHeatLoss(4, 10)
+	return heatLoss
+}
+
+func (g Grid) minHeatLoss(minRun, maxRun int) (int, []crucibleState) {
+	source := crucibleState{
+		Coor: utils.NewCoordinate(0, 0),
+	}
+	destCoor := utils.NewCoordinate(g.Width()-1, g.Height()-1)
+
+	return utils.AStar[crucibleState](
+		g.crucibleGraph(minRun, maxRun),
+		source,
+		func(state crucibleState) bool {
+			// the crucible also needs to have moved at least minRun blocks before it can stop at the end
+			return state.Coor == destCoor && state.numInDir >= minRun
+		},
+		// every block loses at least 1 heat, so the distance left never overestimates
+		func(state crucibleState) int {
+			return state.Coor.StepsToCoordinate(destCoor)
+		},
+	)
+}
+
+//nolint:golint,unused
+func (g Grid) printPath(path []crucibleState) {
+	onPath := map[utils.Coordinate]crucibleState{}
+	for _, state := range path {
+		onPath[state.Coor] = state
+	}
+
+	fmt.Println(g.Render(func(c utils.Coordinate, v int) string {
+		state, ok := onPath[c]
+		if !ok {
+			return fmt.Sprint(v)
+		}
+
+		return state.Dir.Arrow()
+	}))
+}
+
+func parseGrid(r io.Reader) (Grid, error) {
+	g, err := utils.ParseGrid(r, func(r rune) (int, error) {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("invalid heat loss %q", r)
+		}
+		return int(r - '0'), nil
+	})
+	return Grid{g}, err
+}
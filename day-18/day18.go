@@ -0,0 +1,120 @@
+package day18
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+const (
+	TRENCH  = '#'
+	NOTHING = '.'
+)
+
+type Solver struct {
+	steps DigSteps
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.steps, err = parseDigInput(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	return solver.Int(s.steps.Area()), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	partTwoSteps := make(DigSteps, len(s.steps))
+	for i, step := range s.steps {
+		var err error
+		partTwoSteps[i], err = step.PartTwoStep()
+		if err != nil {
+			return solver.Answer{}, err
+		}
+	}
+	return solver.Int(partTwoSteps.Area()), nil
+}
+
+type DigStep struct {
+	Dir      utils.Direction
+	NumToDig int
+	Color    string
+}
+
+// PartTwoStep reads the real step out of the color, where the first 5 digits are the hex number to
+// dig and the last one is the direction
+func (step DigStep) PartTwoStep() (DigStep, error) {
+	dir, err := utils.ParseDirection(step.Color[5:])
+	if err != nil {
+		return DigStep{}, err
+	}
+
+	numToDig, err := strconv.ParseInt(step.Color[:5], 16, 0)
+	if err != nil {
+		return DigStep{}, fmt.Errorf("failed to parse num to dig %q: %w", step.Color[:5], err)
+	}
+
+	return DigStep{
+		Dir:      dir,
+		NumToDig: int(numToDig),
+		Color:    step.Color,
+	}, nil
+}
+
+type DigSteps []DigStep
+
+func (steps DigSteps) polygon() utils.Polygon {
+	return utils.NewPolygonFromSteps(utils.NewCoordinate(0, 0), utils.SliceMap(steps, func(step DigStep) utils.PolygonStep {
+		return utils.PolygonStep{Dir: step.Dir, Len: step.NumToDig}
+	})...)
+}
+
+// Area is how many cubic meters the lagoon holds, which is the trench around the edge plus
+// everything inside it
+func (steps DigSteps) Area() int {
+	return steps.polygon().EnclosedPoints()
+}
+
+func parseDigInput(r io.Reader) (DigSteps, error) {
+	steps := DigSteps{}
+
+	err := utils.ForEachLine(r, func(line string) error {
+		lineSplit := strings.Split(line, " ")
+		if len(lineSplit) != 3 || len(lineSplit[2]) != 9 {
+			return fmt.Errorf("expected a direction, number and color")
+		}
+
+		step := DigStep{}
+
+		var err error
+		step.Dir, err = utils.ParseDirection(lineSplit[0])
+		if err != nil {
+			return err
+		}
+
+		step.NumToDig, err = strconv.Atoi(lineSplit[1])
+		if err != nil {
+			return fmt.Errorf("failed to parse num to dig %q: %w", lineSplit[1], err)
+		}
+		step.Color = lineSplit[2][2:8]
+
+		steps = append(steps, step)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return steps, nil
+}
@@ -0,0 +1,351 @@
+package day19
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+const (
+	X = 'x'
+	M = 'm'
+	A = 'a'
+	S = 's'
+)
+
+const (
+	GREATER_THAN = '>'
+	LESS_THAN    = '<'
+)
+
+var (
+	ErrBadWorkflow     = errors.New("bad workflow")
+	ErrBadPart         = errors.New("bad part")
+	ErrNoInWorkflow    = errors.New("no in workflow")
+	ErrUnknownWorkflow = errors.New("unknown workflow")
+)
+
+type Solver struct {
+	workflows Workflows
+	parts     []Part
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.workflows, s.parts, err = parseWorkflowsAndParts(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	workflowMap := s.workflows.toMap()
+
+	sum := 0
+	for _, p := range s.parts {
+		if p.isAccepted(s.workflows, workflowMap) {
+			sum += p.sum()
+		}
+	}
+	return solver.Int(sum), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	return solver.Int(s.workflows.getNumOfAcceptedPaths()), nil
+}
+
+// categories are the ratings of a part, in the order they're kept in a Part and a Box
+var categories = []utils.Char{X, M, A, S}
+
+type Condition struct {
+	key  utils.Char
+	cond utils.Char
+	num  int
+}
+
+func (c Condition) category() int {
+	return slices.Index(categories, c.key)
+}
+
+func (c Condition) isTrue(p Part) bool {
+	if c.cond == GREATER_THAN {
+		return p[c.category()] > c.num
+	}
+	return p[c.category()] < c.num
+}
+
+// split splits box into the parts that pass the condition and the parts that fail it
+func (c Condition) split(box utils.Box) (utils.Box, utils.Box) {
+	if c.cond == GREATER_THAN {
+		fail, pass := box.SplitAt(c.category(), c.num+1)
+		return pass, fail
+	}
+	return box.SplitAt(c.category(), c.num)
+}
+
+func (c Condition) String() string {
+	return fmt.Sprintf("%s%s%d", c.key, c.cond, c.num)
+}
+
+type WorkflowStep struct {
+	condition *Condition
+	dest      string
+}
+
+func (ws WorkflowStep) String() string {
+	s := ""
+	if ws.condition != nil {
+		s += ws.condition.String() + ":"
+	}
+	return s + ws.dest
+}
+
+type Workflow struct {
+	name  string
+	steps []WorkflowStep
+}
+
+func (w Workflow) String() string {
+	s := w.name + "{"
+	for i, step := range w.steps {
+		s += step.String()
+
+		if i < len(w.steps)-1 {
+			s += ","
+		}
+	}
+
+	return s + "}"
+}
+
+type Workflows []Workflow
+
+func (w Workflows) toMap() map[string]Workflow {
+	m := map[string]Workflow{}
+
+	for _, flow := range w {
+		m[flow.name] = flow
+	}
+
+	return m
+}
+
+func (w Workflows) getNumOfAcceptedPaths() int {
+	workflowMap := w.toMap()
+
+	allParts := make(utils.Box, len(categories))
+	for i := range allParts {
+		allParts[i] = utils.NewInterval(1, 4001)
+	}
+
+	// every box of parts that gets accepted is split off from the rest, so none of them overlap
+	numAcceptedPerms := 0
+
+	var traverse func(workflow Workflow, stepIdx int, parts utils.Box)
+	send := func(dest string, parts utils.Box) {
+		switch dest {
+		case "A":
+			numAcceptedPerms += parts.Volume()
+		case "R":
+		default:
+			traverse(workflowMap[dest], 0, parts)
+		}
+	}
+	traverse = func(workflow Workflow, stepIdx int, parts utils.Box) {
+		step := workflow.steps[stepIdx]
+		if step.condition == nil {
+			send(step.dest, parts)
+			return
+		}
+
+		pass, fail := step.condition.split(parts)
+		if !pass.Empty() {
+			send(step.dest, pass)
+		}
+		if !fail.Empty() {
+			traverse(workflow, stepIdx+1, fail)
+		}
+	}
+
+	traverse(workflowMap["in"], 0, allParts)
+
+	return numAcceptedPerms
+}
+
+// Part has a rating for each of the categories
+type Part [4]int
+
+func (p Part) isAccepted(workflows Workflows, workflowMap map[string]Workflow) bool {
+	return checkWorkflow(p, workflowMap["in"], workflowMap)
+}
+
+func (p Part) sum() int {
+	return utils.IntSliceSum(p[:])
+}
+
+func checkWorkflow(p Part, workflow Workflow, workflowMap map[string]Workflow) bool {
+	for _, step := range workflow.steps {
+		if step.condition == nil || step.condition.isTrue(p) {
+			switch step.dest {
+			case "A":
+				return true
+			case "R":
+				return false
+			default:
+				return checkWorkflow(p, workflowMap[step.dest], workflowMap)
+			}
+		}
+	}
+
+	return false
+}
+
+// validate makes sure every workflow can be followed through to A or R, starting from in
+func (w Workflows) validate() error {
+	workflowMap := w.toMap()
+	if _, ok := workflowMap["in"]; !ok {
+		return ErrNoInWorkflow
+	}
+
+	for _, flow := range w {
+		for _, step := range flow.steps {
+			if _, ok := workflowMap[step.dest]; !ok && step.dest != "A" && step.dest != "R" {
+				return fmt.Errorf("%w: %s sends parts to %q", ErrUnknownWorkflow, flow.name, step.dest)
+			}
+		}
+	}
+	return nil
+}
+
+func parseWorkflow(line string) (Workflow, error) {
+	name, rest, ok := strings.Cut(line, "{")
+	rest, hasEnd := strings.CutSuffix(rest, "}")
+	if !ok || !hasEnd || name == "" {
+		return Workflow{}, fmt.Errorf("%w: expected name{steps}", ErrBadWorkflow)
+	}
+
+	workflow := Workflow{
+		name:  name,
+		steps: []WorkflowStep{},
+	}
+
+	steps := strings.Split(rest, ",")
+	for i, step := range steps {
+		if !strings.Contains(step, ":") {
+			if i != len(steps)-1 {
+				return Workflow{}, fmt.Errorf("%w: step %q without a condition isn't last", ErrBadWorkflow, step)
+			}
+			if step == "" {
+				return Workflow{}, fmt.Errorf("%w: empty step", ErrBadWorkflow)
+			}
+			workflow.steps = append(workflow.steps, WorkflowStep{
+				condition: nil,
+				dest:      step,
+			})
+			continue
+		}
+		if i == len(steps)-1 {
+			return Workflow{}, fmt.Errorf("%w: last step %q has a condition", ErrBadWorkflow, step)
+		}
+
+		cond, dest, _ := strings.Cut(step, ":")
+		if len(cond) < 3 || dest == "" {
+			return Workflow{}, fmt.Errorf("%w: expected a step like x>10:dest, got %q", ErrBadWorkflow, step)
+		}
+		if !slices.Contains(categories, utils.Char(cond[0])) {
+			return Workflow{}, fmt.Errorf("%w: unknown category in condition %q", ErrBadWorkflow, cond)
+		}
+		if cond[1] != GREATER_THAN && cond[1] != LESS_THAN {
+			return Workflow{}, fmt.Errorf("%w: unknown comparison in condition %q", ErrBadWorkflow, cond)
+		}
+		num, err := strconv.Atoi(cond[2:])
+		if err != nil {
+			return Workflow{}, fmt.Errorf("failed to parse num %q: %w", cond[2:], err)
+		}
+
+		workflow.steps = append(workflow.steps, WorkflowStep{
+			condition: &Condition{
+				key:  utils.Char(cond[0]),
+				cond: utils.Char(cond[1]),
+				num:  num,
+			},
+			dest: dest,
+		})
+	}
+
+	return workflow, nil
+}
+
+func parsePart(line string) (Part, error) {
+	vals, ok := strings.CutPrefix(line, "{")
+	vals, hasEnd := strings.CutSuffix(vals, "}")
+	if !ok || !hasEnd {
+		return Part{}, fmt.Errorf("%w: expected {ratings}", ErrBadPart)
+	}
+
+	part := Part{}
+	for _, v := range strings.Split(vals, ",") {
+		k, numStr, _ := strings.Cut(v, "=")
+		num, err := strconv.Atoi(numStr)
+		if err != nil {
+			return Part{}, fmt.Errorf("failed to parse num %q: %w", numStr, err)
+		}
+
+		idx := -1
+		if len(k) == 1 {
+			idx = slices.Index(categories, utils.Char(k[0]))
+		}
+		if idx < 0 {
+			return Part{}, fmt.Errorf("%w: unknown category %q", ErrBadPart, k)
+		}
+		part[idx] = num
+	}
+
+	return part, nil
+}
+
+func parseWorkflowsAndParts(r io.Reader) (Workflows, []Part, error) {
+	workflows := Workflows{}
+	parts := []Part{}
+	parseWorkflows := true
+
+	err := utils.ForEachLine(r, func(line string) error {
+		if line == "" {
+			parseWorkflows = false
+			return nil
+		}
+
+		if parseWorkflows {
+			workflow, err := parseWorkflow(line)
+			if err != nil {
+				return err
+			}
+			workflows = append(workflows, workflow)
+			return nil
+		}
+
+		part, err := parsePart(line)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, part)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := workflows.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return workflows, parts, nil
+}
@@ -0,0 +1,56 @@
+package day19
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(19114),
+			Part2: solver.Int(167409079868000),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
+
+func TestParseBadInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"workflow without braces", "in\n\n{x=1,m=2,a=3,s=4}", ErrBadWorkflow},
+		{"workflow without closing brace", "in{A", ErrBadWorkflow},
+		{"short condition", "in{x>:A,R}", ErrBadWorkflow},
+		{"unknown comparison", "in{x=1:A,R}", ErrBadWorkflow},
+		{"unknown category", "in{q>1:A,R}", ErrBadWorkflow},
+		{"condition on last step", "in{x>1:A}", ErrBadWorkflow},
+		{"no condition before last step", "in{A,x>1:R}", ErrBadWorkflow},
+		{"one character part", "in{A}\n\n{", ErrBadPart},
+		{"part without braces", "in{A}\n\nx=1,m=2,a=3,s=4", ErrBadPart},
+		{"part with unknown category", "in{A}\n\n{xx=1}", ErrBadPart},
+		{"no in workflow", "px{A}\n\n{x=1,m=2,a=3,s=4}", ErrNoInWorkflow},
+		{"unknown workflow", "in{x>1:px,R}\n\n{x=1,m=2,a=3,s=4}", ErrUnknownWorkflow},
+	}
+
+	for _, tt := range tests {
+		err := New().Parse(strings.NewReader(tt.input))
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
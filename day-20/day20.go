@@ -0,0 +1,242 @@
+package day20
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+var (
+	ErrNoSingleRxFeeder = errors.New("rx isn't fed by a single conjunction module")
+	ErrFeederNeverFires = errors.New("module never sent the wanted pulse")
+)
+
+// maxButtonPresses is how long to wait for every module to send the wanted pulse before giving up
+const maxButtonPresses = 100_000
+
+type Solver struct {
+	modules ModulesMap
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.modules, err = parseModules(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	modules := s.modules.copy()
+
+	lowPulses := 0
+	highPulses := 0
+
+	for i := 0; i < 1000; i++ {
+		l, h := countPulseAfterButtonPush(modules)
+		lowPulses += l
+		highPulses += h
+	}
+
+	return solver.Int(lowPulses * highPulses), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	cMap := s.modules.toConnectionMap()
+
+	// rx is fed by a single conjunction, which only sends it a low pulse once every one of its own
+	// inputs has sent it a high pulse. each of those inputs sends one on its own cycle.
+	feeders := cMap.InNeighbors("rx")
+	if len(feeders) != 1 {
+		return solver.Answer{}, fmt.Errorf("%w: found %d", ErrNoSingleRxFeeder, len(feeders))
+	}
+	if _, ok := s.modules[feeders[0]].(*ConjunctionModule); !ok {
+		return solver.Answer{}, fmt.Errorf("%w: %s isn't a conjunction", ErrNoSingleRxFeeder, feeders[0])
+	}
+
+	freqs, err := findFrequencyOfPulsesFromMods(s.modules.copy(), HighPulse, cMap.InNeighbors(feeders[0])...)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	presses, err := utils.LeastCommonMultiple(freqs)
+	if errors.Is(err, utils.ErrOverflow) {
+		return solver.BigInt(utils.BigLeastCommonMultiple(freqs)), nil
+	}
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(presses), nil
+}
+
+// Graph draws how the modules connect, colored by what kind of module they are
+func (s *Solver) Graph(format utils.GraphFormat) (string, error) {
+	exporter := utils.GraphExporter[string]{
+		Graph:    s.modules.toConnectionMap(),
+		Directed: true,
+		NodeAttrs: func(name string) utils.ExportAttrs {
+			switch s.modules[name].(type) {
+			case *FlipFlopModule:
+				return utils.ExportAttrs{Label: "%" + name, Color: "blue"}
+			case *ConjunctionModule:
+				return utils.ExportAttrs{Label: "&" + name, Color: "red"}
+			case *BroadcastModule:
+				return utils.ExportAttrs{Color: "green"}
+			}
+			return utils.ExportAttrs{}
+		},
+	}
+	return exporter.Export(format)
+}
+
+type ModulesMap map[string]Module
+
+func (m ModulesMap) copy() ModulesMap {
+	newM := make(ModulesMap, len(m))
+
+	for k, v := range m {
+		newM[k] = v.Copy()
+	}
+
+	return newM
+}
+
+func (m ModulesMap) toConnectionMap() utils.ConnectionMap[string] {
+	cMap := make(utils.ConnectionMap[string], len(m))
+
+	for mName, mod := range m {
+		cMap[mName] = map[string]int{}
+		for _, o := range mod.Outputs() {
+			cMap[mName][o] = 1
+		}
+	}
+
+	return cMap
+}
+
+func parseModules(r io.Reader) (ModulesMap, error) {
+	inputs := map[string][]string{}
+	outputs := map[string][]string{}
+
+	err := utils.ForEachLine(r, func(line string) error {
+		modName, outputsStr, _ := strings.Cut(line, " -> ")
+
+		outputsSplit := utils.SliceMap(strings.Split(outputsStr, ","), func(s string) string {
+			return strings.TrimSpace(s)
+		})
+
+		outputs[modName] = outputsSplit
+
+		for _, o := range outputsSplit {
+			if _, ok := inputs[o]; !ok {
+				inputs[o] = []string{}
+			}
+			inputs[o] = append(inputs[o], strings.TrimLeft(modName, "%&"))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	modules := ModulesMap{}
+
+	for modName, outputs := range outputs {
+		if modName == "broadcaster" {
+			modules[modName] = NewBroadcastModule(modName, outputs)
+		} else if strings.HasPrefix(modName, "%") {
+			modules[modName[1:]] = NewFlipFlopModule(modName[1:], outputs)
+		} else if strings.HasPrefix(modName, "&") {
+			modules[modName[1:]] = NewConjunctionModule(modName[1:], inputs[modName[1:]], outputs)
+		}
+	}
+
+	return modules, nil
+}
+
+func countPulseAfterButtonPush(modules ModulesMap) (int, int) {
+	lowPulses := 0
+	highPulses := 0
+
+	pulsesToDo := utils.NewQueue[PulseMessage]()
+	pulsesToDo.Push(PulseMessage{
+		FromModule: "button",
+		ToModule:   "broadcaster",
+		PulseVal:   LowPulse,
+	})
+
+	for pulsesToDo.Len() > 0 {
+		msg := pulsesToDo.Pop()
+		mod, modExists := modules[msg.ToModule]
+
+		if msg.PulseVal == HighPulse {
+			highPulses++
+		} else {
+			lowPulses++
+		}
+
+		if modExists {
+			for _, p := range mod.ReceivePulse(msg.FromModule, msg.PulseVal) {
+				pulsesToDo.Push(p)
+			}
+		}
+	}
+
+	return lowPulses, highPulses
+}
+
+func findFrequencyOfPulsesFromMods(modules ModulesMap, wantedPulse Pulse, modNames ...string) ([]int, error) {
+	modsHitWithHigh := 0
+	modHitWithHigh := make([]int, len(modNames))
+	buttonPresses := 0
+
+	pulsesToDo := utils.NewQueue[PulseMessage]()
+	pulsesFired := 0
+
+	for modsHitWithHigh < len(modNames) {
+		if buttonPresses == maxButtonPresses {
+			missing := []string{}
+			for i, name := range modNames {
+				if modHitWithHigh[i] == 0 {
+					missing = append(missing, name)
+				}
+			}
+			return nil, fmt.Errorf("%w: %s after %d presses", ErrFeederNeverFires, strings.Join(missing, ", "), buttonPresses)
+		}
+		buttonPresses++
+		pulsesToDo.Push(PulseMessage{
+			FromModule: "button",
+			ToModule:   "broadcaster",
+			PulseVal:   LowPulse,
+		})
+
+		for pulsesToDo.Len() > 0 {
+			msg := pulsesToDo.Pop()
+			mod, modExists := modules[msg.ToModule]
+
+			if msg.PulseVal == wantedPulse {
+				searchModIdx := slices.Index(modNames, msg.FromModule)
+				if searchModIdx > -1 && modHitWithHigh[searchModIdx] == 0 {
+					modsHitWithHigh++
+					modHitWithHigh[searchModIdx] = buttonPresses
+				}
+			}
+
+			if modExists {
+				for _, p := range mod.ReceivePulse(msg.FromModule, msg.PulseVal) {
+					pulsesToDo.Push(p)
+				}
+			}
+			pulsesFired++
+		}
+	}
+
+	return modHitWithHigh, nil
+}
@@ -0,0 +1,59 @@
+package day20
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(32000000),
+		},
+		{
+			Input: "testdata/example2.txt",
+			Part1: solver.Int(11687500),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "", 2)
+}
+
+func TestPart2NeedsRx(t *testing.T) {
+	s := New()
+	f, err := os.Open("testdata/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := s.Parse(f); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Part2(); !errors.Is(err, ErrNoSingleRxFeeder) {
+		t.Errorf("expected ErrNoSingleRxFeeder, got %v", err)
+	}
+}
+
+func TestPart2FeederNeverFires(t *testing.T) {
+	// b never gets a pulse, so it never sends fd a high one
+	s := New()
+	if err := s.Parse(strings.NewReader("broadcaster -> a\n%a -> fd\n&b -> fd\n&fd -> rx")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Part2(); !errors.Is(err, ErrFeederNeverFires) {
+		t.Errorf("expected ErrFeederNeverFires, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package day21
+
+import (
+	"io"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+const (
+	START utils.Char = 'S'
+	OPEN  utils.Char = '.'
+	ROCK  utils.Char = '#'
+)
+
+type Solver struct {
+	grid Grid
+	// number of steps the elf takes in part one
+	steps int
+}
+
+func New() solver.Solver {
+	return &Solver{
+		steps: 64,
+	}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.grid, err = parseGrid(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	return solver.Int(s.grid.AvailableSpotsFromSteps(s.steps)), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	grid := s.grid
+
+	points := []int{}
+	for i := 0; i < 3; i++ {
+		points = append(points, grid.AvailableSpotsFromSteps(65+131*i))
+		grid = grid.expand()
+	}
+
+	return solver.Int(utils.NevilleInterpolation([]int{0, 1, 2}, points, (26501365-65)/131)), nil
+}
+
+type Grid struct {
+	utils.Grid[utils.Char]
+}
+
+func (g Grid) AvailableSpotsFromSteps(maxSteps int) int {
+	distances, _ := utils.BFS[utils.Coordinate](g.gardenGraph(), g.findStart())
+
+	s := 0
+	for _, d := range distances {
+		if d >= 0 && d <= maxSteps && d%2 == maxSteps%2 {
+			s++
+		}
+	}
+	return s
+}
+
+func (g Grid) findStart() utils.Coordinate {
+	// start is always in the middle of the grid for the input/samples
+	return utils.NewCoordinate(g.Width()/2, g.Height()/2)
+}
+
+// gardenGraph connects each garden plot to the plots next to it
+func (g Grid) gardenGraph() utils.GraphFunc[utils.Coordinate] {
+	return func(coor utils.Coordinate) []utils.Edge[utils.Coordinate] {
+		edges := []utils.Edge[utils.Coordinate]{}
+		for _, neighbor := range g.Neighbors4(coor) {
+			if g.Get(neighbor) != ROCK {
+				edges = append(edges, utils.Edge[utils.Coordinate]{To: neighbor, Weight: 1})
+			}
+		}
+		return edges
+	}
+}
+
+func (g Grid) expand() Grid {
+	newGrid := utils.NewGrid(3*g.Width(), 3*g.Height(), OPEN)
+
+	newGrid.ForEach(func(coor utils.Coordinate, _ utils.Char) {
+		newGrid.Set(coor, g.Get(utils.NewCoordinate(coor.X%g.Width(), coor.Y%g.Height())))
+	})
+
+	return Grid{newGrid}
+}
+
+func parseGrid(r io.Reader) (Grid, error) {
+	g, err := utils.ParseCharGrid(r)
+	return Grid{g}, err
+}
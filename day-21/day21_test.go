@@ -0,0 +1,26 @@
+package day21
+
+import (
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	// part two relies on the shape of the real input, so only part one is checked
+	solvertest.Run(t, func() solver.Solver { return &Solver{steps: 6} }, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(16),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "", 2)
+}
@@ -1,11 +1,13 @@
-package main
+package day22
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"slices"
 	"strings"
 
+	"github.com/mellena1/advent-of-code-2023/solver"
 	"github.com/mellena1/advent-of-code-2023/utils"
 )
 
@@ -17,14 +19,40 @@ const (
 	ZAxis Axis = 'Z'
 )
 
-func main() {
-	f := utils.ReadFile("input.txt")
-	defer f.Close()
+var (
+	ErrBadBrick = errors.New("bad brick")
+	ErrNoBricks = errors.New("no bricks")
+)
+
+type Solver struct {
+	bricks Bricks
+}
 
-	bricks := parseBricks(f)
-	bricks.MoveAllDown()
-	fmt.Printf("Part one solution: %d\n", bricks.NumCanBeDisintegrated())
-	fmt.Printf("Part two solution: %d\n", bricks.NumBricksThatWouldFall())
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	bricks, err := parseBricks(r)
+	if err != nil {
+		return err
+	}
+	if len(bricks) == 0 {
+		return ErrNoBricks
+	}
+
+	s.bricks = bricks
+	s.bricks.MoveAllDown()
+
+	return nil
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	return solver.Int(s.bricks.NumCanBeDisintegrated()), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	return solver.Int(s.bricks.NumBricksThatWouldFall()), nil
 }
 
 type Bricks []Brick
@@ -85,6 +113,19 @@ func (b Bricks) MoveAllDown() int {
 	return numMovedDown
 }
 
+// unit is a step of one along the axis
+func (a Axis) unit() utils.Coordinate3D[int] {
+	switch a {
+	case XAxis:
+		return utils.NewCoordinate3D(1, 0, 0)
+	case YAxis:
+		return utils.NewCoordinate3D(0, 1, 0)
+	case ZAxis:
+		return utils.NewCoordinate3D(0, 0, 1)
+	}
+	return utils.Coordinate3D[int]{}
+}
+
 type Brick struct {
 	Start utils.Coordinate3D[int]
 	End   utils.Coordinate3D[int]
@@ -121,23 +162,13 @@ func (b Brick) ForEachCoor(f func(c utils.Coordinate3D[int]) bool) {
 }
 
 func (b Brick) Add(axis Axis, num int) Brick {
-	newBrick := Brick{
-		Axis: b.Axis,
-	}
+	offset := axis.unit().Scale(num)
 
-	switch axis {
-	case XAxis:
-		newBrick.Start = b.Start.Translate(num, 0, 0)
-		newBrick.End = b.End.Translate(num, 0, 0)
-	case YAxis:
-		newBrick.Start = b.Start.Translate(0, num, 0)
-		newBrick.End = b.End.Translate(0, num, 0)
-	case ZAxis:
-		newBrick.Start = b.Start.Translate(0, 0, num)
-		newBrick.End = b.End.Translate(0, 0, num)
+	return Brick{
+		Start: b.Start.Add(offset),
+		End:   b.End.Add(offset),
+		Axis:  b.Axis,
 	}
-
-	return newBrick
 }
 
 func (b Brick) Intersects(c utils.Coordinate3D[int]) bool {
@@ -170,11 +201,14 @@ func (b Brick) CanMoveDown(bricks Bricks) bool {
 	return noIntersections
 }
 
-func parseBricks(r io.Reader) Bricks {
+func parseBricks(r io.Reader) (Bricks, error) {
 	bricks := Bricks{}
 
-	utils.ExecutePerLine(r, func(line string) error {
-		startCoor, endCoor, _ := strings.Cut(line, "~")
+	err := utils.ForEachLine(r, func(line string) error {
+		startCoor, endCoor, ok := strings.Cut(line, "~")
+		if !ok {
+			return fmt.Errorf("%w: expected start~end", ErrBadBrick)
+		}
 
 		start, err := strCoorTo3DCoor(startCoor)
 		if err != nil {
@@ -204,8 +238,11 @@ func parseBricks(r io.Reader) Bricks {
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return bricks
+	return bricks, nil
 }
 
 func strCoorTo3DCoor(s string) (utils.Coordinate3D[int], error) {
@@ -213,6 +250,9 @@ func strCoorTo3DCoor(s string) (utils.Coordinate3D[int], error) {
 	if err != nil {
 		return utils.Coordinate3D[int]{}, err
 	}
+	if len(nums) != 3 {
+		return utils.Coordinate3D[int]{}, fmt.Errorf("%w: expected 3 ints in %q", ErrBadBrick, s)
+	}
 
 	return utils.NewCoordinate3D(nums[0], nums[1], nums[2]), nil
 }
@@ -0,0 +1,47 @@
+package day22
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(5),
+			Part2: solver.Int(7),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
+
+func TestParseBadInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"too few ints", "1,2,3~4,5", ErrBadBrick},
+		{"too many ints", "1,2,3,4~4,5,6", ErrBadBrick},
+		{"no end", "1,2,3", ErrBadBrick},
+		{"no bricks", "", ErrNoBricks},
+	}
+
+	for _, tt := range tests {
+		if err := New().Parse(strings.NewReader(tt.input)); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package day23
+
+import (
+	"errors"
+	"io"
+	"slices"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+const (
+	PATH        utils.Char = '.'
+	FOREST      utils.Char = '#'
+	SLOPE_RIGHT utils.Char = '>'
+	SLOPE_LEFT  utils.Char = '<'
+	SLOPE_UP    utils.Char = '^'
+	SLOPE_DOWN  utils.Char = 'v'
+)
+
+var ErrGridTooSmall = errors.New("grid is too small to have a start and end")
+
+type Solver struct {
+	grid Grid
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.grid, err = parseGrid(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	steps, err := s.grid.longestHike()
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(steps), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	grid := s.grid.copy()
+	grid.removeSlopes()
+
+	steps, err := grid.longestHike()
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	return solver.Int(steps), nil
+}
+
+// slopes can only be walked down in the direction they point
+var slopes = map[utils.Char]utils.Coordinate{
+	SLOPE_RIGHT: utils.NewCoordinate(1, 0),
+	SLOPE_LEFT:  utils.NewCoordinate(-1, 0),
+	SLOPE_UP:    utils.NewCoordinate(0, -1),
+	SLOPE_DOWN:  utils.NewCoordinate(0, 1),
+}
+
+type Grid struct {
+	utils.Grid[utils.Char]
+}
+
+func (g Grid) StringWithPath(path []utils.Coordinate) string {
+	return g.Render(func(c utils.Coordinate, v utils.Char) string {
+		if slices.Contains(path, c) {
+			return "O"
+		}
+		return v.String()
+	})
+}
+
+func (g Grid) start() utils.Coordinate {
+	return utils.NewCoordinate(1, 0)
+}
+
+func (g Grid) dest() utils.Coordinate {
+	return utils.NewCoordinate(g.Width()-2, g.Height()-1)
+}
+
+func (g Grid) copy() Grid {
+	return Grid{g.Clone()}
+}
+
+func (g Grid) removeSlopes() {
+	for _, coor := range g.FindAll(func(v utils.Char) bool { return v != PATH && v != FOREST }) {
+		g.Set(coor, PATH)
+	}
+}
+
+// longestHike contracts the corridors between junctions and finds the longest path from start to dest
+func (g Grid) longestHike() (int, error) {
+	startCoor, destCoor := g.start(), g.dest()
+	cMap, _ := g.toConnectionMap().ContractChains(func(c utils.Coordinate) bool {
+		return c == startCoor || c == destCoor
+	}, utils.KeepLongestChain)
+
+	steps, _, err := utils.LongestSimplePath[utils.Coordinate](cMap, startCoor, destCoor)
+	return steps, err
+}
+
+func (g Grid) toConnectionMap() utils.ConnectionMap[utils.Coordinate] {
+	cMap := utils.ConnectionMap[utils.Coordinate]{}
+
+	g.ForEach(func(coor utils.Coordinate, v utils.Char) {
+		if v == FOREST {
+			return
+		}
+
+		cMap[coor] = map[utils.Coordinate]int{}
+
+		if dir, ok := slopes[v]; ok {
+			cMap[coor][coor.Add(dir)] = 1
+			return
+		}
+
+		for _, neighbor := range g.Neighbors4(coor) {
+			neighborV := g.Get(neighbor)
+			if neighborV == FOREST {
+				continue
+			}
+			// can't step onto a slope that points back up at us
+			if dir, ok := slopes[neighborV]; ok && neighbor.Add(dir) == coor {
+				continue
+			}
+			cMap[coor][neighbor] = 1
+		}
+	})
+
+	return cMap
+}
+
+func parseGrid(r io.Reader) (Grid, error) {
+	g, err := utils.ParseCharGrid(r)
+	if err != nil {
+		return Grid{}, err
+	}
+	if g.Width() < 3 {
+		return Grid{}, ErrGridTooSmall
+	}
+	return Grid{g}, nil
+}
@@ -0,0 +1,78 @@
+package day23
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(94),
+			Part2: solver.Int(154),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
+
+func TestParseTooSmall(t *testing.T) {
+	for _, input := range []string{"", "#.\n.#"} {
+		if err := New().Parse(strings.NewReader(input)); !errors.Is(err, ErrGridTooSmall) {
+			t.Errorf("%q: expected %v, got %v", input, ErrGridTooSmall, err)
+		}
+	}
+}
+
+func TestUnreachableEnd(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		part  int
+	}{
+		{"walled off", "#.#\n###\n#.#", 1},
+		{"walled off", "#.#\n###\n#.#", 2},
+		{"slope points back at the start", "#.#\n#^#\n#.#", 1},
+	}
+
+	for _, tt := range tests {
+		s := New()
+		if err := s.Parse(strings.NewReader(tt.input)); err != nil {
+			t.Fatalf("%s: failed to parse: %v", tt.name, err)
+		}
+
+		part := s.Part1
+		if tt.part == 2 {
+			part = s.Part2
+		}
+		if _, err := part(); !errors.Is(err, utils.ErrNoPath) {
+			t.Errorf("%s part %d: expected %v, got %v", tt.name, tt.part, utils.ErrNoPath, err)
+		}
+	}
+}
+
+func TestSlopesOnlyBlockPart1(t *testing.T) {
+	s := New()
+	if err := s.Parse(strings.NewReader("#.#\n#^#\n#.#")); err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	got, err := s.Part2()
+	if err != nil {
+		t.Fatalf("part 2 failed: %v", err)
+	}
+	if got != solver.Int(2) {
+		t.Errorf("expected %v, got %v", solver.Int(2), got)
+	}
+}
@@ -0,0 +1,283 @@
+package day24
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+var (
+	ErrBadHailstone = errors.New("bad hailstone")
+)
+
+type Solver struct {
+	hailstones Hailstones
+	// the test area for part one
+	minPos int
+	maxPos int
+}
+
+func New() solver.Solver {
+	return &Solver{
+		minPos: 200000000000000,
+		maxPos: 400000000000000,
+	}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.hailstones, err = parseHailstones(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	return solver.Int(s.hailstones.NumIntersections2D(s.minPos, s.maxPos)), nil
+}
+
+func (s *Solver) Part2() (solver.Answer, error) {
+	threeHailstones, err := findThreeHailstones(s.hailstones)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	rock, err := findRock(threeHailstones)
+	if err != nil {
+		return solver.Answer{}, err
+	}
+
+	sum, err := utils.CheckedSum([]int{rock.Pos.X, rock.Pos.Y, rock.Pos.Z})
+	if err != nil {
+		return solver.BigInt(utils.BigSum([]int{rock.Pos.X, rock.Pos.Y, rock.Pos.Z})), nil
+	}
+	return solver.Int(sum), nil
+}
+
+type Hailstones []Hailstone
+
+// NumIntersections2D counts the pairs of hailstones whose paths cross inside the test area, ignoring Z.
+// The positions are big enough that float64 can't be trusted, so the math is done with exact rationals.
+func (h Hailstones) NumIntersections2D(minPos, maxPos int) int {
+	bigMin := new(big.Rat).SetInt64(int64(minPos))
+	bigMax := new(big.Rat).SetInt64(int64(maxPos))
+	inTestArea := func(v *big.Rat) bool {
+		return v.Cmp(bigMin) >= 0 && v.Cmp(bigMax) <= 0
+	}
+
+	numIntersections := 0
+
+	for i, h1 := range h {
+		for j, h2 := range h {
+			if i <= j {
+				continue
+			}
+
+			t1, t2, doIntersect := intersectionOf2DVectors([]int{h1.Pos.X, h1.Pos.Y}, []int{h1.Vel.X, h1.Vel.Y}, []int{h2.Pos.X, h2.Pos.Y}, []int{h2.Vel.X, h2.Vel.Y})
+			if !doIntersect || t1.Sign() < 0 || t2.Sign() < 0 {
+				continue
+			}
+
+			x, y := h1.posAtTime2D(t1)
+			if !inTestArea(x) || !inTestArea(y) {
+				continue
+			}
+
+			numIntersections++
+		}
+	}
+
+	return numIntersections
+}
+
+type Velocities utils.Coordinate3D[int]
+
+func (v Velocities) Equal(v2 Velocities) bool {
+	return v == v2
+}
+
+type Hailstone struct {
+	Pos utils.Coordinate3D[int]
+	Vel Velocities
+}
+
+func (h Hailstone) posAtTime2D(t *big.Rat) (*big.Rat, *big.Rat) {
+	at := func(pos, vel int) *big.Rat {
+		v := new(big.Rat).SetInt64(int64(vel))
+		return v.Add(v.Mul(v, t), new(big.Rat).SetInt64(int64(pos)))
+	}
+	return at(h.Pos.X, h.Vel.X), at(h.Pos.Y, h.Vel.Y)
+}
+
+func parseHailstones(r io.Reader) (Hailstones, error) {
+	hailstones := []Hailstone{}
+
+	err := utils.ForEachLine(r, func(line string) error {
+		pos, vel, ok := strings.Cut(line, " @ ")
+		if !ok {
+			return fmt.Errorf("%w: expected position @ velocity", ErrBadHailstone)
+		}
+
+		posInts, err := posOrVelToInts(pos)
+		if err != nil {
+			return err
+		}
+
+		velInts, err := posOrVelToInts(vel)
+		if err != nil {
+			return err
+		}
+
+		hailstones = append(hailstones, Hailstone{
+			Pos: utils.NewCoordinate3D(posInts[0], posInts[1], posInts[2]),
+			Vel: Velocities(utils.NewCoordinate3D(velInts[0], velInts[1], velInts[2])),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hailstones, nil
+}
+
+func posOrVelToInts(s string) ([]int, error) {
+	spl := strings.Split(s, ",")
+	tSpl := utils.SliceMap(spl, func(v string) string {
+		return strings.TrimSpace(v)
+	})
+	nums, err := utils.StrSliceToIntSlice(tSpl)
+	if err != nil {
+		return nil, err
+	}
+	if len(nums) != 3 {
+		return nil, fmt.Errorf("%w: expected 3 ints in %q", ErrBadHailstone, s)
+	}
+	return nums, nil
+}
+
+func intersectionOf2DVectors(p1 []int, v1 []int, p2 []int, v2 []int) (*big.Rat, *big.Rat, bool) {
+	// https://math.stackexchange.com/a/406895
+	bigInts := func(ns []int) []*big.Int {
+		return utils.SliceMap(ns, func(n int) *big.Int { return big.NewInt(int64(n)) })
+	}
+	cross := func(a, b []*big.Int) *big.Int {
+		l := new(big.Int).Mul(a[1], b[0])
+		return l.Sub(l, new(big.Int).Mul(a[0], b[1]))
+	}
+
+	bv1, bv2 := bigInts(v1), bigInts(v2)
+	c := bigInts([]int{p2[0] - p1[0], p2[1] - p1[1]})
+
+	d := cross(bv1, bv2)
+	if d.Sign() == 0 {
+		return nil, nil, false
+	}
+
+	dt := cross(c, bv2)
+	du := cross(c, bv1)
+
+	return new(big.Rat).SetFrac(dt, d), new(big.Rat).SetFrac(du, d), true
+}
+
+func findThreeHailstones(hailstones Hailstones) (Hailstones, error) {
+	for i := 0; i < len(hailstones)-2; i++ {
+		a := hailstones[i]
+		for j := i + 1; j < len(hailstones)-1; j++ {
+			b := hailstones[j]
+			if a.Vel.Equal(b.Vel) {
+				continue
+			}
+			for k := j + 1; k < len(hailstones); k++ {
+				c := hailstones[k]
+				if a.Vel.Equal(c.Vel) || b.Vel.Equal(c.Vel) {
+					continue
+				}
+				return Hailstones{a, b, c}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no matches found")
+}
+
+func findRock(hailstones Hailstones) (Hailstone, error) {
+	// used https://github.com/DeadlyRedCube/AdventOfCode/blob/1f9d0a3e3b7e7821592244ee51bce5c18cf899ff/2023/AOC2023/D24.h#L66-L294 as the baseline for figuring out the math
+	// basically you need 3 hailstones to calc what the rock's vector should look like, since you get 9 equations with 9 vars, which is solvable
+	// the problem with those 3 is you have v*t[i] in each equation, which makes them unsolvable with linear algebra. you can solve for t[i] in each,
+	// and use that to substitute values for t[i] into each, giving you equations with 6 vars total, and you can do some algebra to rearrange them to end
+	// with linear equations. m below is the outcome of doing so and putting it into a matrix, and then we can use gaussian elimination to solve.
+	// the numbers are so large that it's solved with exact rationals to not lose any precision.
+
+	a := hailstones[0]
+	b := hailstones[1]
+	c := hailstones[2]
+
+	// the positions are around 10^14, so multiplying them by velocities can overflow an int
+	m := [][]*big.Int{
+		pairEquation(a, b, Y, X),
+		pairEquation(a, c, Y, X),
+		pairEquation(a, b, Z, X),
+		pairEquation(a, c, Z, X),
+		pairEquation(a, b, Z, Y),
+		pairEquation(a, c, Z, Y),
+	}
+
+	solution, err := utils.SolveBigLinearSystem(m)
+	if err != nil {
+		return Hailstone{}, err
+	}
+
+	x := make([]int, len(solution))
+	for i, v := range solution {
+		x[i], err = utils.RatToInt(v)
+		if err != nil {
+			return Hailstone{}, fmt.Errorf("rock isn't at a whole number position: %w", err)
+		}
+	}
+
+	return Hailstone{
+		Pos: utils.NewCoordinate3D(x[0], x[1], x[2]),
+		Vel: Velocities(utils.NewCoordinate3D(x[3], x[4], x[5])),
+	}, nil
+}
+
+const (
+	X = iota
+	Y
+	Z
+)
+
+func (h Hailstone) pos(axis int) *big.Int {
+	return big.NewInt(int64([3]int{h.Pos.X, h.Pos.Y, h.Pos.Z}[axis]))
+}
+
+func (h Hailstone) vel(axis int) *big.Int {
+	return big.NewInt(int64([3]int{h.Vel.X, h.Vel.Y, h.Vel.Z}[axis]))
+}
+
+// moment is pos[i]*vel[j] - pos[j]*vel[i]
+func (h Hailstone) moment(i, j int) *big.Int {
+	m := new(big.Int).Mul(h.pos(i), h.vel(j))
+	return m.Sub(m, new(big.Int).Mul(h.pos(j), h.vel(i)))
+}
+
+// pairEquation is one row of the system findRock solves, from the rock hitting both a and b, looking only at
+// axes i and j. The unknowns are the rock's position and then its velocity, each in X, Y, Z order.
+func pairEquation(a, b Hailstone, i, j int) []*big.Int {
+	sub := func(x, y *big.Int) *big.Int { return new(big.Int).Sub(x, y) }
+
+	row := make([]*big.Int, 7)
+	for k := range row {
+		row[k] = new(big.Int)
+	}
+	row[j] = sub(b.vel(i), a.vel(i))
+	row[i] = sub(a.vel(j), b.vel(j))
+	row[3+j] = sub(a.pos(i), b.pos(i))
+	row[3+i] = sub(b.pos(j), a.pos(j))
+	row[6] = sub(a.moment(i, j), b.moment(i, j))
+	return row
+}
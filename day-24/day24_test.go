@@ -0,0 +1,72 @@
+package day24
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, func() solver.Solver { return &Solver{minPos: 7, maxPos: 27} }, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(2),
+			Part2: solver.Int(47),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func BenchmarkPart2(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 2)
+}
+
+func TestFindRockLargeNumbers(t *testing.T) {
+	// positions this big times velocities in the hundreds don't fit in an int
+	rock := Hailstone{
+		Pos: utils.NewCoordinate3D(50_000_000_000_000_000, 60_000_000_000_000_000, 70_000_000_000_000_000),
+		Vel: Velocities(utils.NewCoordinate3D(10, -20, 30)),
+	}
+	hitAt := func(vel utils.Coordinate3D[int], t int) Hailstone {
+		return Hailstone{
+			Pos: rock.Pos.Add(utils.Coordinate3D[int](rock.Vel).Sub(vel).Scale(t)),
+			Vel: Velocities(vel),
+		}
+	}
+
+	hailstones := Hailstones{
+		hitAt(utils.NewCoordinate3D(400, -300, 500), 5),
+		hitAt(utils.NewCoordinate3D(-450, 350, -200), 7),
+		hitAt(utils.NewCoordinate3D(300, 480, -410), 11),
+	}
+
+	got, err := findRock(hailstones)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != rock {
+		t.Errorf("got %v, want %v", got, rock)
+	}
+}
+
+func TestParseBadInput(t *testing.T) {
+	inputs := []string{
+		"1, 2, 3 @ 4, 5",
+		"1, 2 @ 4, 5, 6",
+		"1, 2, 3, 4 @ 4, 5, 6",
+		"1, 2, 3",
+	}
+
+	for _, input := range inputs {
+		if err := New().Parse(strings.NewReader(input)); !errors.Is(err, ErrBadHailstone) {
+			t.Errorf("%q: expected %v, got %v", input, ErrBadHailstone, err)
+		}
+	}
+}
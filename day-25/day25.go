@@ -0,0 +1,189 @@
+package day25
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+var (
+	ErrNoThreeWireCut = errors.New("can't split the graph by cutting 3 wires")
+)
+
+const (
+	betweenessPivots = 100
+	betweenessSeed   = 25
+)
+
+type Solver struct {
+	graph ComponentGraph
+}
+
+func New() solver.Solver {
+	return &Solver{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var err error
+	s.graph, err = parseComponents(r)
+	return err
+}
+
+func (s *Solver) Part1() (solver.Answer, error) {
+	graph := s.graph.copy()
+
+	cMap := graph.toConnectionGraph()
+	// the edges that the most shortest paths go through are a quick guess at the wires to cut. the three
+	// wires stand out so much that sampling some of the paths is enough to find them
+	edgeBetweeness := cMap.ApproxEdgeBetweeness(betweenessPivots, betweenessSeed)
+	if threeEdgesToCut, ok := findHighestThreeEdges(edgeBetweeness); ok {
+		for _, e := range threeEdgesToCut {
+			graph.CutEdge(e)
+		}
+
+		if groups := graph.toConnectionGraph().Components(); len(groups) == 2 {
+			return solver.Int(len(groups[0]) * len(groups[1])), nil
+		}
+	}
+
+	// the guess didn't split the graph in two, so find the cut the slow but sure way
+	cut, err := cMap.MinCut()
+	if err != nil {
+		return solver.Answer{}, err
+	}
+	if cut.Weight != 3 {
+		return solver.Answer{}, fmt.Errorf("%w: min cut is %d wires, not 3", ErrNoThreeWireCut, cut.Weight)
+	}
+
+	return solver.Int(len(cut.A) * len(cut.B)), nil
+}
+
+// there is no part two puzzle on the last day
+func (s *Solver) Part2() (solver.Answer, error) {
+	return solver.Answer{}, solver.ErrNoSuchPart
+}
+
+// Graph draws the wires between components, with the three that look like they should be cut in red
+func (s *Solver) Graph(format utils.GraphFormat) (string, error) {
+	cMap := s.graph.toConnectionGraph()
+	toCut, _ := findHighestThreeEdges(cMap.ApproxEdgeBetweeness(betweenessPivots, betweenessSeed))
+
+	exporter := utils.GraphExporter[string]{
+		Graph: utils.AdjacencyList[string](s.graph),
+		EdgeAttrs: func(from, to string, _ int) utils.ExportAttrs {
+			if slices.Contains(toCut, [2]string{from, to}) || slices.Contains(toCut, [2]string{to, from}) {
+				return utils.ExportAttrs{Color: "red"}
+			}
+			return utils.ExportAttrs{}
+		},
+	}
+	return exporter.Export(format)
+}
+
+type ComponentGraph map[string][]string
+
+func (g ComponentGraph) copy() ComponentGraph {
+	newG := make(ComponentGraph, len(g))
+	for k, v := range g {
+		newG[k] = slices.Clone(v)
+	}
+	return newG
+}
+
+func (g ComponentGraph) CutEdge(edge [2]string) {
+	g[edge[0]] = slices.DeleteFunc(g[edge[0]], func(s string) bool {
+		return s == edge[1]
+	})
+	g[edge[1]] = slices.DeleteFunc(g[edge[1]], func(s string) bool {
+		return s == edge[0]
+	})
+}
+
+func (g ComponentGraph) toConnectionGraph() utils.ConnectionMap[string] {
+	cMap := make(utils.ConnectionMap[string])
+
+	for n, neighbors := range g {
+		cMap[n] = map[string]int{}
+		for _, neighbor := range neighbors {
+			cMap[n][neighbor] = 1
+		}
+	}
+
+	return cMap
+}
+
+// findHighestThreeEdges returns the three undirected edges with the highest betweeness, or false if
+// there aren't three edges
+func findHighestThreeEdges(betweeness map[string]map[string]float64) ([][2]string, bool) {
+	type edgeWithWeight struct {
+		edge   [2]string
+		weight float64
+	}
+
+	// sampled paths don't go through an edge the same amount both ways, so add both directions together
+	weights := map[[2]string]float64{}
+	for n, neighbors := range betweeness {
+		for neighbor, weight := range neighbors {
+			edge := [2]string{n, neighbor}
+			if neighbor < n {
+				edge = [2]string{neighbor, n}
+			}
+			weights[edge] += weight
+		}
+	}
+	if len(weights) < 3 {
+		return nil, false
+	}
+
+	edgesToWeight := make([]edgeWithWeight, 0, len(weights))
+	for edge, weight := range weights {
+		edgesToWeight = append(edgesToWeight, edgeWithWeight{edge: edge, weight: weight})
+	}
+
+	slices.SortFunc(edgesToWeight, func(a, b edgeWithWeight) int {
+		if c := cmp.Compare(b.weight, a.weight); c != 0 {
+			return c
+		}
+		// break ties by name so the same edges always win
+		return slices.Compare(a.edge[:], b.edge[:])
+	})
+
+	return utils.SliceMap(edgesToWeight[:3], func(e edgeWithWeight) [2]string {
+		return e.edge
+	}), true
+}
+
+func parseComponents(r io.Reader) (ComponentGraph, error) {
+	g := ComponentGraph{}
+
+	err := utils.ForEachLine(r, func(line string) error {
+		source, destsStr, _ := strings.Cut(line, ":")
+
+		dests := strings.Split(strings.TrimSpace(destsStr), " ")
+
+		for _, d := range dests {
+			if _, ok := g[source]; !ok {
+				g[source] = []string{}
+			}
+			g[source] = append(g[source], d)
+
+			if _, ok := g[d]; !ok {
+				g[d] = []string{}
+			}
+			g[d] = append(g[d], source)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
@@ -0,0 +1,108 @@
+package day25
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+func TestExamples(t *testing.T) {
+	solvertest.Run(t, New, []solvertest.Example{
+		{
+			Input: "testdata/example.txt",
+			Part1: solver.Int(54),
+		},
+	})
+}
+
+func BenchmarkPart1(b *testing.B) {
+	solvertest.Benchmark(b, New, "testdata/example.txt", 1)
+}
+
+func TestMinCutMatchesExample(t *testing.T) {
+	f, err := os.Open("testdata/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	graph, err := parseComponents(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cut, err := graph.toConnectionGraph().MinCut()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cut.Weight != 3 || len(cut.A)*len(cut.B) != 54 {
+		t.Errorf("got cut of %d wires into groups of %d and %d", cut.Weight, len(cut.A), len(cut.B))
+	}
+}
+
+func TestFindHighestThreeEdgesFractionalWeights(t *testing.T) {
+	// approximate betweeness is scaled, so the weights can be less than 1 apart
+	betweeness := map[string]map[string]float64{
+		"a": {"b": 0.2, "c": 0.9},
+		"b": {"a": 0.2, "d": 0.5},
+		"c": {"a": 0.9, "e": 0.7},
+		"d": {"b": 0.5, "e": 0.1},
+		"e": {"c": 0.7, "d": 0.1},
+	}
+
+	got, ok := findHighestThreeEdges(betweeness)
+	if !ok {
+		t.Fatal("expected three edges")
+	}
+	want := [][2]string{{"a", "c"}, {"c", "e"}, {"b", "d"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestFindHighestThreeEdgesTooFewEdges(t *testing.T) {
+	betweeness := map[string]map[string]float64{
+		"a": {"b": 1},
+		"b": {"a": 1, "c": 2},
+		"c": {"b": 2},
+	}
+
+	if got, ok := findHighestThreeEdges(betweeness); ok {
+		t.Errorf("expected no edges, got %v", got)
+	}
+}
+
+func TestPart1TooFewWires(t *testing.T) {
+	s := New()
+	if err := s.Parse(strings.NewReader("a: b c")); err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if _, err := s.Part1(); !errors.Is(err, ErrNoThreeWireCut) {
+		t.Errorf("expected %v, got %v", ErrNoThreeWireCut, err)
+	}
+}
+
+func TestFindHighestThreeEdgesAddsBothDirections(t *testing.T) {
+	// sampled betweeness isn't the same both ways along an edge
+	betweeness := map[string]map[string]float64{
+		"a": {"b": 0.1},
+		"b": {"a": 0.9, "c": 0.3},
+		"c": {"b": 0.3, "d": 0.2},
+		"d": {"c": 0.2, "e": 0.35},
+		"e": {"d": 0.35},
+	}
+
+	got, ok := findHighestThreeEdges(betweeness)
+	if !ok {
+		t.Fatal("expected three edges")
+	}
+	want := [][2]string{{"a", "b"}, {"d", "e"}, {"b", "c"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
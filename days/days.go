@@ -0,0 +1,69 @@
+package days
+
+import (
+	"fmt"
+
+	day01 "github.com/mellena1/advent-of-code-2023/day-01"
+	day02 "github.com/mellena1/advent-of-code-2023/day-02"
+	day03 "github.com/mellena1/advent-of-code-2023/day-03"
+	day04 "github.com/mellena1/advent-of-code-2023/day-04"
+	day05 "github.com/mellena1/advent-of-code-2023/day-05"
+	day06 "github.com/mellena1/advent-of-code-2023/day-06"
+	day07 "github.com/mellena1/advent-of-code-2023/day-07"
+	day08 "github.com/mellena1/advent-of-code-2023/day-08"
+	day09 "github.com/mellena1/advent-of-code-2023/day-09"
+	day10 "github.com/mellena1/advent-of-code-2023/day-10"
+	day11 "github.com/mellena1/advent-of-code-2023/day-11"
+	day12 "github.com/mellena1/advent-of-code-2023/day-12"
+	day13 "github.com/mellena1/advent-of-code-2023/day-13"
+	day14 "github.com/mellena1/advent-of-code-2023/day-14"
+	day15 "github.com/mellena1/advent-of-code-2023/day-15"
+	day16 "github.com/mellena1/advent-of-code-2023/day-16"
+	day17 "github.com/mellena1/advent-of-code-2023/day-17"
+	day18 "github.com/mellena1/advent-of-code-2023/day-18"
+	day19 "github.com/mellena1/advent-of-code-2023/day-19"
+	day20 "github.com/mellena1/advent-of-code-2023/day-20"
+	day21 "github.com/mellena1/advent-of-code-2023/day-21"
+	day22 "github.com/mellena1/advent-of-code-2023/day-22"
+	day23 "github.com/mellena1/advent-of-code-2023/day-23"
+	day24 "github.com/mellena1/advent-of-code-2023/day-24"
+	day25 "github.com/mellena1/advent-of-code-2023/day-25"
+	"github.com/mellena1/advent-of-code-2023/solver"
+)
+
+// Solvers maps each day of the advent calendar to a constructor for its solver
+var Solvers = map[int]func() solver.Solver{
+	1:  day01.New,
+	2:  day02.New,
+	3:  day03.New,
+	4:  day04.New,
+	5:  day05.New,
+	6:  day06.New,
+	7:  day07.New,
+	8:  day08.New,
+	9:  day09.New,
+	10: day10.New,
+	11: day11.New,
+	12: day12.New,
+	13: day13.New,
+	14: day14.New,
+	15: day15.New,
+	16: day16.New,
+	17: day17.New,
+	18: day18.New,
+	19: day19.New,
+	20: day20.New,
+	21: day21.New,
+	22: day22.New,
+	23: day23.New,
+	24: day24.New,
+	25: day25.New,
+}
+
+func New(day int) (solver.Solver, error) {
+	newSolver, ok := Solvers[day]
+	if !ok {
+		return nil, fmt.Errorf("no solver for day %d", day)
+	}
+	return newSolver(), nil
+}
@@ -0,0 +1,73 @@
+package days
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+	"github.com/mellena1/advent-of-code-2023/solver/solvertest"
+)
+
+// answersFile holds the answers for the real puzzle inputs. Since inputs and answers are
+// private to each account, neither are checked in, and the test is skipped without them.
+const answersFile = "../answers.json"
+
+type dayAnswers struct {
+	Part1 solver.Answer `json:"part1"`
+	Part2 solver.Answer `json:"part2"`
+}
+
+func TestRealInputs(t *testing.T) {
+	b, err := os.ReadFile(answersFile)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Skipf("no %s, skipping real inputs", answersFile)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	answers := map[string]dayAnswers{}
+	if err := json.Unmarshal(b, &answers); err != nil {
+		t.Fatalf("failed to parse %s: %s", answersFile, err)
+	}
+
+	for day := 1; day <= 25; day++ {
+		dayAnswers, ok := answers[strconv.Itoa(day)]
+		if !ok {
+			continue
+		}
+
+		input := filepath.Join("..", fmt.Sprintf("day-%02d", day), "input.txt")
+		if _, err := os.Stat(input); err != nil {
+			continue
+		}
+
+		for i, want := range []solver.Answer{dayAnswers.Part1, dayAnswers.Part2} {
+			if want.IsZero() {
+				continue
+			}
+
+			part := i + 1
+			t.Run(fmt.Sprintf("day%02d/part%d", day, part), func(t *testing.T) {
+				s, err := New(day)
+				if err != nil {
+					t.Fatal(err)
+				}
+				solvertest.CheckPart(t, s, input, part, want)
+			})
+		}
+	}
+}
+
+func TestAllDaysRegistered(t *testing.T) {
+	for day := 1; day <= 25; day++ {
+		if _, err := New(day); err != nil {
+			t.Errorf("day %d: %s", day, err)
+		}
+	}
+}
@@ -1,5 +1,3 @@
 module github.com/mellena1/advent-of-code-2023
 
 go 1.21.4
-
-require github.com/shopspring/decimal v1.3.1
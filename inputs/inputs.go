@@ -0,0 +1,119 @@
+// Package inputs caches puzzle inputs on disk so they are only ever downloaded once
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// CacheDirEnvVar overrides where inputs are cached
+	CacheDirEnvVar = "AOC_CACHE_DIR"
+)
+
+var (
+	ErrInputCached = errors.New("input is already cached")
+	ErrNotCached   = errors.New("input is not cached")
+)
+
+// Fetcher downloads the puzzle input for a day
+type Fetcher interface {
+	FetchInput(day int) ([]byte, error)
+}
+
+// Store keeps inputs in Dir as NN.txt, fetching them with Fetcher when they're missing
+type Store struct {
+	Dir     string
+	Fetcher Fetcher
+}
+
+func NewStore(dir string, fetcher Fetcher) *Store {
+	return &Store{
+		Dir:     dir,
+		Fetcher: fetcher,
+	}
+}
+
+// DefaultDir is $AOC_CACHE_DIR if set, otherwise aoc/2023 in the user's cache dir (e.g. ~/.cache/aoc/2023)
+func DefaultDir() (string, error) {
+	if dir := os.Getenv(CacheDirEnvVar); dir != "" {
+		return dir, nil
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find cache dir: %w", err)
+	}
+
+	return filepath.Join(cacheDir, "aoc", "2023"), nil
+}
+
+func (s *Store) Path(day int) string {
+	return filepath.Join(s.Dir, fmt.Sprintf("%02d.txt", day))
+}
+
+func (s *Store) IsCached(day int) bool {
+	_, err := os.Stat(s.Path(day))
+	return err == nil
+}
+
+// Get returns the cached input for a day, fetching and caching it first if it isn't cached yet
+func (s *Store) Get(day int) ([]byte, error) {
+	data, err := os.ReadFile(s.Path(day))
+	if err == nil {
+		return data, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read cached input: %w", err)
+	}
+
+	if err := s.Fetch(day); err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(s.Path(day))
+}
+
+// Fetch downloads the input for a day into the cache. Inputs never change, so it
+// refuses to fetch an input that is already cached.
+func (s *Store) Fetch(day int) error {
+	if s.IsCached(day) {
+		return fmt.Errorf("day %d: %w", day, ErrInputCached)
+	}
+
+	if s.Fetcher == nil {
+		return fmt.Errorf("day %d: %w, and there is no fetcher", day, ErrNotCached)
+	}
+
+	data, err := s.Fetcher.FetchInput(day)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return fmt.Errorf("failed to make cache dir: %w", err)
+	}
+
+	// write to a temp file first so a failed write never leaves a partial input behind
+	tmp, err := os.CreateTemp(s.Dir, ".input-*")
+	if err != nil {
+		return fmt.Errorf("failed to cache input: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to cache input: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to cache input: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.Path(day)); err != nil {
+		return fmt.Errorf("failed to cache input: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+)
+
+type fakeFetcher struct {
+	fetches map[int]int
+	err     error
+}
+
+func (f *fakeFetcher) FetchInput(day int) ([]byte, error) {
+	f.fetches[day]++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []byte(fmt.Sprintf("input for day %d\n", day)), nil
+}
+
+func TestStoreGetOnlyFetchesOnce(t *testing.T) {
+	fetcher := &fakeFetcher{fetches: map[int]int{}}
+	store := NewStore(t.TempDir(), fetcher)
+
+	for i := 0; i < 3; i++ {
+		input, err := store.Get(7)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if string(input) != "input for day 7\n" {
+			t.Errorf("got input %q", input)
+		}
+	}
+
+	if fetcher.fetches[7] != 1 {
+		t.Errorf("fetched day 7 %d times, want 1", fetcher.fetches[7])
+	}
+
+	if err := store.Fetch(7); !errors.Is(err, ErrInputCached) {
+		t.Errorf("expected ErrInputCached refetching, got %v", err)
+	}
+}
+
+func TestStoreFetchError(t *testing.T) {
+	fetcher := &fakeFetcher{fetches: map[int]int{}, err: errors.New("rate limited")}
+	store := NewStore(t.TempDir(), fetcher)
+
+	if _, err := store.Get(3); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if store.IsCached(3) {
+		t.Error("failed fetch should not be cached")
+	}
+
+	entries, _ := os.ReadDir(store.Dir)
+	if len(entries) != 0 {
+		t.Errorf("expected an empty cache dir, found %d entries", len(entries))
+	}
+}
+
+func TestStoreWithoutFetcher(t *testing.T) {
+	store := NewStore(t.TempDir(), nil)
+
+	if _, err := store.Get(1); !errors.Is(err, ErrNotCached) {
+		t.Errorf("expected ErrNotCached, got %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+// Package ledger records the verdicts of submitted answers, so answers that are
+// already known to be wrong never get sent again
+package ledger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mellena1/advent-of-code-2023/client"
+	"github.com/mellena1/advent-of-code-2023/solver"
+)
+
+const (
+	// PathEnvVar overrides where the ledger is kept
+	PathEnvVar = "AOC_LEDGER"
+)
+
+var (
+	ErrKnownWrong    = errors.New("answer was already submitted and was wrong")
+	ErrOutOfRange    = errors.New("answer is out of the range allowed by earlier verdicts")
+	ErrAlreadySolved = errors.New("part is already solved")
+)
+
+type Entry struct {
+	Day     int            `json:"day"`
+	Part    int            `json:"part"`
+	Answer  solver.Answer  `json:"answer"`
+	Verdict client.Verdict `json:"verdict"`
+	Time    time.Time      `json:"time"`
+}
+
+// Ledger is every recorded verdict, kept in a JSON file at Path
+type Ledger struct {
+	Path    string
+	Entries []Entry
+}
+
+// DefaultPath is $AOC_LEDGER if set, otherwise aoc/2023/ledger.json in the user's config dir (e.g. ~/.config/aoc/2023/ledger.json)
+func DefaultPath() (string, error) {
+	if path := os.Getenv(PathEnvVar); path != "" {
+		return path, nil
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find config dir: %w", err)
+	}
+
+	return filepath.Join(configDir, "aoc", "2023", "ledger.json"), nil
+}
+
+// Load reads the ledger at path. A missing file is an empty ledger.
+func Load(path string) (*Ledger, error) {
+	l := &Ledger{Path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ledger: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &l.Entries); err != nil {
+		return nil, fmt.Errorf("failed to parse ledger %s: %w", path, err)
+	}
+
+	return l, nil
+}
+
+// Check returns an error if answer shouldn't be submitted for a day and part: the part is already
+// solved, the same answer was wrong before, or it's outside the bounds of earlier too high/too low verdicts.
+func (l *Ledger) Check(day, part int, answer solver.Answer) error {
+	n, isNum := answer.BigInt()
+
+	for _, e := range l.entriesFor(day, part) {
+		if e.Verdict == client.VerdictRight {
+			if e.Answer.Equal(answer) {
+				return fmt.Errorf("%w with answer %s", ErrAlreadySolved, e.Answer)
+			}
+			return fmt.Errorf("%w with a different answer %s", ErrAlreadySolved, e.Answer)
+		}
+
+		if e.Verdict.IsWrong() && e.Answer.Equal(answer) {
+			return fmt.Errorf("%w (%s)", ErrKnownWrong, e.Verdict)
+		}
+
+		if !isNum {
+			continue
+		}
+		bound, ok := e.Answer.BigInt()
+		if !ok {
+			continue
+		}
+
+		switch {
+		case e.Verdict == client.VerdictTooHigh && n.Cmp(bound) >= 0:
+			return fmt.Errorf("%w: %s was too high", ErrOutOfRange, e.Answer)
+		case e.Verdict == client.VerdictTooLow && n.Cmp(bound) <= 0:
+			return fmt.Errorf("%w: %s was too low", ErrOutOfRange, e.Answer)
+		}
+	}
+
+	return nil
+}
+
+// Record adds a verdict to the ledger and saves it. Verdicts that say nothing
+// about the answer, like being rate limited, aren't recorded.
+func (l *Ledger) Record(day, part int, answer solver.Answer, verdict client.Verdict) error {
+	if verdict != client.VerdictRight && !verdict.IsWrong() {
+		return nil
+	}
+
+	l.Entries = append(l.Entries, Entry{
+		Day:     day,
+		Part:    part,
+		Answer:  answer,
+		Verdict: verdict,
+		Time:    time.Now().UTC(),
+	})
+
+	return l.Save()
+}
+
+// Save writes the ledger to its path
+func (l *Ledger) Save() error {
+	data, err := json.MarshalIndent(l.Entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode ledger: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.Path), 0o700); err != nil {
+		return fmt.Errorf("failed to make ledger dir: %w", err)
+	}
+
+	// write to a temp file first so a failed write never loses earlier verdicts
+	tmp, err := os.CreateTemp(filepath.Dir(l.Path), ".ledger-*")
+	if err != nil {
+		return fmt.Errorf("failed to save ledger: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save ledger: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save ledger: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), l.Path); err != nil {
+		return fmt.Errorf("failed to save ledger: %w", err)
+	}
+
+	return nil
+}
+
+func (l *Ledger) entriesFor(day, part int) []Entry {
+	entries := []Entry{}
+	for _, e := range l.Entries {
+		if e.Day == day && e.Part == part {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
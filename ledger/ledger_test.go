@@ -0,0 +1,82 @@
+package ledger
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/client"
+	"github.com/mellena1/advent-of-code-2023/solver"
+)
+
+func TestCheck(t *testing.T) {
+	l, err := Load(filepath.Join(t.TempDir(), "ledger.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	record := func(day, part int, answer solver.Answer, verdict client.Verdict) {
+		if err := l.Record(day, part, answer, verdict); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	record(1, 1, solver.Int(100), client.VerdictTooHigh)
+	record(1, 1, solver.Int(10), client.VerdictTooLow)
+	record(1, 1, solver.Int(50), client.VerdictWrong)
+	record(1, 1, solver.Int(60), client.VerdictRateLimited)
+	record(2, 1, solver.String("abc"), client.VerdictWrong)
+	record(3, 2, solver.Int(7), client.VerdictRight)
+
+	tests := []struct {
+		day, part int
+		answer    solver.Answer
+		want      error
+	}{
+		{1, 1, solver.Int(100), ErrKnownWrong},
+		{1, 1, solver.Int(150), ErrOutOfRange},
+		{1, 1, solver.Int(10), ErrKnownWrong},
+		{1, 1, solver.Int(-3), ErrOutOfRange},
+		{1, 1, solver.Int(50), ErrKnownWrong},
+		{1, 1, solver.String("50"), ErrKnownWrong},
+		{1, 1, solver.Int(60), nil},
+		{1, 1, solver.Int(99), nil},
+		{1, 2, solver.Int(150), nil},
+		{2, 1, solver.String("abc"), ErrKnownWrong},
+		{2, 1, solver.String("abd"), nil},
+		{3, 2, solver.Int(7), ErrAlreadySolved},
+		{3, 2, solver.Int(8), ErrAlreadySolved},
+	}
+
+	for _, tt := range tests {
+		err := l.Check(tt.day, tt.part, tt.answer)
+		if tt.want == nil && err != nil {
+			t.Errorf("day %d part %d answer %s: unexpected error: %s", tt.day, tt.part, tt.answer, err)
+		}
+		if tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("day %d part %d answer %s: got error %v, want %v", tt.day, tt.part, tt.answer, err, tt.want)
+		}
+	}
+}
+
+func TestLoadRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "ledger.json")
+
+	l, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := l.Record(24, 1, solver.String("123456789012345678901234567890"), client.VerdictTooLow); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	l, err = Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(l.Entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(l.Entries))
+	}
+	if err := l.Check(24, 1, solver.String("123456789012345678901234567889")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("got error %v, want ErrOutOfRange", err)
+	}
+}
@@ -0,0 +1,94 @@
+package solver
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// Answer is the solution to one part of a puzzle. It holds either an int, a big int or a string.
+type Answer struct {
+	val any
+}
+
+func Int(n int) Answer {
+	return Answer{val: n}
+}
+
+func BigInt(n *big.Int) Answer {
+	return Answer{val: new(big.Int).Set(n)}
+}
+
+func String(s string) Answer {
+	return Answer{val: s}
+}
+
+// Int returns the answer as an int, if it can be represented as one
+func (a Answer) Int() (int, bool) {
+	switch v := a.val.(type) {
+	case int:
+		return v, true
+	case *big.Int:
+		if v.IsInt64() {
+			return int(v.Int64()), true
+		}
+	case string:
+		n, err := strconv.Atoi(v)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// BigInt returns the answer as a big int, if it is numeric
+func (a Answer) BigInt() (*big.Int, bool) {
+	switch v := a.val.(type) {
+	case int:
+		return big.NewInt(int64(v)), true
+	case *big.Int:
+		return new(big.Int).Set(v), true
+	case string:
+		return new(big.Int).SetString(v, 10)
+	}
+	return nil, false
+}
+
+func (a Answer) IsZero() bool {
+	return a.val == nil
+}
+
+// Equal compares answers by their printed value, so Int(5) and String("5") are equal
+func (a Answer) Equal(b Answer) bool {
+	return a.String() == b.String()
+}
+
+func (a Answer) String() string {
+	switch v := a.val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (a Answer) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Answer) UnmarshalText(text []byte) error {
+	s := string(text)
+
+	if n, err := strconv.Atoi(s); err == nil {
+		*a = Int(n)
+		return nil
+	}
+
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		a.val = n
+		return nil
+	}
+
+	*a = String(s)
+	return nil
+}
@@ -0,0 +1,46 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mellena1/advent-of-code-2023/utils"
+)
+
+var (
+	ErrNoSuchPart = errors.New("puzzle has no such part")
+	ErrNoGraph    = errors.New("puzzle has no graph to export")
+)
+
+// Solver parses a day's puzzle input and solves both of its parts
+type Solver interface {
+	Parse(r io.Reader) error
+	Part1() (Answer, error)
+	Part2() (Answer, error)
+}
+
+// SolvePart solves part 1 or part 2 of an already parsed puzzle
+func SolvePart(s Solver, part int) (Answer, error) {
+	switch part {
+	case 1:
+		return s.Part1()
+	case 2:
+		return s.Part2()
+	}
+	return Answer{}, fmt.Errorf("%w: %d", ErrNoSuchPart, part)
+}
+
+// Grapher is a Solver whose puzzle input is a graph, which can be exported for drawing once it's parsed
+type Grapher interface {
+	Graph(format utils.GraphFormat) (string, error)
+}
+
+// ExportGraph exports an already parsed puzzle's graph, if it has one
+func ExportGraph(s Solver, format utils.GraphFormat) (string, error) {
+	g, ok := s.(Grapher)
+	if !ok {
+		return "", ErrNoGraph
+	}
+	return g.Graph(format)
+}
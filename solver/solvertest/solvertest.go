@@ -0,0 +1,98 @@
+// Package solvertest runs solvers against puzzle inputs with known answers
+package solvertest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/mellena1/advent-of-code-2023/solver"
+)
+
+// Example is a puzzle input along with its expected answers.
+// A zero Answer means that part isn't checked for this input.
+type Example struct {
+	Input string
+	Part1 solver.Answer
+	Part2 solver.Answer
+}
+
+// Run checks each example against a fresh solver from newSolver for every part,
+// so that parts can't rely on state left behind by each other
+func Run(t *testing.T, newSolver func() solver.Solver, examples []Example) {
+	t.Helper()
+
+	for _, ex := range examples {
+		for i, want := range []solver.Answer{ex.Part1, ex.Part2} {
+			if want.IsZero() {
+				continue
+			}
+
+			part := i + 1
+			t.Run(fmt.Sprintf("%s/part%d", ex.Input, part), func(t *testing.T) {
+				CheckPart(t, newSolver(), ex.Input, part, want)
+			})
+		}
+	}
+}
+
+// CheckPart parses the input file with s and checks that the given part solves to want
+func CheckPart(t *testing.T, s solver.Solver, input string, part int, want solver.Answer) {
+	t.Helper()
+
+	f, err := os.Open(input)
+	if err != nil {
+		t.Fatalf("failed to open input: %s", err)
+	}
+	defer f.Close()
+
+	if err := s.Parse(f); err != nil {
+		t.Fatalf("failed to parse %s: %s", input, err)
+	}
+
+	got, err := solver.SolvePart(s, part)
+	if err != nil {
+		t.Fatalf("part %d returned error: %s", part, err)
+	}
+
+	if !got.Equal(want) {
+		t.Errorf("part %d = %s, want %s", part, got, want)
+	}
+}
+
+// Benchmark times one part of the puzzle, parsing the input into a fresh solver before each run.
+// The real input.txt is used when it exists, otherwise example is used. If example is empty
+// the benchmark is skipped without a real input, for parts the examples can't exercise.
+func Benchmark(b *testing.B, newSolver func() solver.Solver, example string, part int) {
+	b.Helper()
+
+	input := "input.txt"
+	if _, err := os.Stat(input); err != nil {
+		if example == "" {
+			b.Skip("no input.txt")
+		}
+		input = example
+	}
+
+	data, err := os.ReadFile(input)
+	if err != nil {
+		b.Fatalf("failed to read input: %s", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		s := newSolver()
+		if err := s.Parse(bytes.NewReader(data)); err != nil {
+			b.Fatalf("failed to parse %s: %s", input, err)
+		}
+		b.StartTimer()
+
+		if _, err := solver.SolvePart(s, part); err != nil {
+			b.Fatalf("part %d returned error: %s", part, err)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package utils
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+func (cMap ConnectionMap[K]) Betweeness() map[K]float64 {
+	return Betweeness[K](cMap)
+}
+
+func (cMap ConnectionMap[K]) EdgeBetweeness() map[K]map[K]float64 {
+	return EdgeBetweeness[K](cMap)
+}
+
+func (cMap ConnectionMap[K]) ApproxBetweeness(k int, seed int64) map[K]float64 {
+	return ApproxBetweeness[K](cMap, k, seed)
+}
+
+func (cMap ConnectionMap[K]) ApproxEdgeBetweeness(k int, seed int64) map[K]map[K]float64 {
+	return ApproxEdgeBetweeness[K](cMap, k, seed)
+}
+
+// Betweeness is how many shortest paths between other nodes go through each node, ignoring edge weights.
+// The work is spread across goroutines, so g.Neighbors needs to be safe to call from more than one at once.
+func Betweeness[K comparable](g FiniteGraph[K]) map[K]float64 {
+	return brandes(g, g.Nodes(), 1, newNodeBetweeness[K])
+}
+
+// EdgeBetweeness is how many shortest paths between nodes go through each edge, ignoring edge weights
+func EdgeBetweeness[K comparable](g FiniteGraph[K]) map[K]map[K]float64 {
+	return brandes(g, g.Nodes(), 1, newEdgeBetweeness[K])
+}
+
+// ApproxBetweeness estimates Betweeness by only following the shortest paths out of k randomly picked
+// nodes, and scaling the result up to make up for the rest. The same seed always picks the same nodes.
+func ApproxBetweeness[K comparable](g FiniteGraph[K], k int, seed int64) map[K]float64 {
+	pivots, scale := pickPivots(g, k, seed)
+	return brandes(g, pivots, scale, newNodeBetweeness[K])
+}
+
+// ApproxEdgeBetweeness estimates EdgeBetweeness the same way ApproxBetweeness estimates Betweeness
+func ApproxEdgeBetweeness[K comparable](g FiniteGraph[K], k int, seed int64) map[K]map[K]float64 {
+	pivots, scale := pickPivots(g, k, seed)
+	return brandes(g, pivots, scale, newEdgeBetweeness[K])
+}
+
+// pickPivots picks k of the graph's nodes at random, along with how much to scale up what's found
+// from them by. The nodes are sorted first so their order in the graph doesn't change which get picked.
+func pickPivots[K comparable](g FiniteGraph[K], k int, seed int64) ([]K, float64) {
+	nodes := g.Nodes()
+	k = max(k, 1)
+	if k >= len(nodes) {
+		return nodes, 1
+	}
+
+	keys := make(map[K]string, len(nodes))
+	for _, n := range nodes {
+		keys[n] = fmt.Sprint(n)
+	}
+	slices.SortFunc(nodes, func(a, b K) int {
+		return cmp.Compare(keys[a], keys[b])
+	})
+
+	r := rand.New(rand.NewSource(seed))
+	r.Shuffle(len(nodes), func(i, j int) {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	})
+
+	return nodes[:k], float64(len(nodes)) / float64(k)
+}
+
+// betweenessResult adds up the shortest paths found from each source node
+type betweenessResult[K comparable, R any] interface {
+	accumulate(n K, stack *Stack[K], p map[K][]K, delta, sigma map[K]float64)
+	// merge adds other into this result, scaled by scale
+	merge(other R, scale float64)
+	result() R
+}
+
+type nodeBetweeness[K comparable] map[K]float64
+
+func newNodeBetweeness[K comparable]() betweenessResult[K, map[K]float64] {
+	return nodeBetweeness[K]{}
+}
+
+func (cb nodeBetweeness[K]) accumulate(n K, stack *Stack[K], p map[K][]K, delta, sigma map[K]float64) {
+	for stack.Len() > 0 {
+		w := stack.Pop()
+		for _, v := range p[w] {
+			delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+		}
+		if w != n {
+			if d := delta[w]; d != 0 {
+				cb[w] += d
+			}
+		}
+	}
+}
+
+func (cb nodeBetweeness[K]) merge(other map[K]float64, scale float64) {
+	for n, c := range other {
+		cb[n] += c * scale
+	}
+}
+
+func (cb nodeBetweeness[K]) result() map[K]float64 {
+	return cb
+}
+
+type edgeBetweeness[K comparable] map[K]map[K]float64
+
+func newEdgeBetweeness[K comparable]() betweenessResult[K, map[K]map[K]float64] {
+	return edgeBetweeness[K]{}
+}
+
+func (cb edgeBetweeness[K]) accumulate(n K, stack *Stack[K], p map[K][]K, delta, sigma map[K]float64) {
+	for stack.Len() != 0 {
+		w := stack.Pop()
+		for _, v := range p[w] {
+			c := sigma[v] / sigma[w] * (1 + delta[w])
+			if _, ok := cb[v]; !ok {
+				cb[v] = map[K]float64{}
+			}
+			cb[v][w] += c
+			delta[v] += c
+		}
+	}
+}
+
+func (cb edgeBetweeness[K]) merge(other map[K]map[K]float64, scale float64) {
+	for v, neighbors := range other {
+		if _, ok := cb[v]; !ok {
+			cb[v] = make(map[K]float64, len(neighbors))
+		}
+		for w, c := range neighbors {
+			cb[v][w] += c * scale
+		}
+	}
+}
+
+func (cb edgeBetweeness[K]) result() map[K]map[K]float64 {
+	return cb
+}
+
+// brandesChunks is how many pieces the sources are split into. It's fixed rather than based on how many
+// goroutines there are, so the results are added up in the same order on every machine.
+const brandesChunks = 64
+
+// brandes finds the shortest paths out of each source and has a result accumulate them. The sources
+// are split up into chunks that each get their own result, goroutines take chunks to work on with their
+// own scratch maps, and the results are merged together in chunk order at the end, scaled by scale.
+func brandes[K comparable, R any](g FiniteGraph[K], sources []K, scale float64, newResult func() betweenessResult[K, R]) R {
+	// based off of gonum's implementation: https://github.com/gonum/gonum/blob/v0.14.0/graph/network/betweenness.go
+
+	nodes := g.Nodes()
+	chunkSize := max((len(sources)+brandesChunks-1)/brandesChunks, 1)
+	chunks := (len(sources) + chunkSize - 1) / chunkSize
+	workers := min(runtime.GOMAXPROCS(0), max(chunks, 1))
+
+	results := make([]betweenessResult[K, R], chunks)
+	toDo := make(chan int, chunks)
+	for c := range results {
+		results[c] = newResult()
+		toDo <- c
+	}
+	close(toDo)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			p := make(map[K][]K, len(nodes))
+			sigma := make(map[K]float64, len(nodes))
+			d := make(map[K]int, len(nodes))
+			delta := make(map[K]float64, len(nodes))
+
+			queue := NewQueue[K]()
+
+			for c := range toDo {
+				for _, n := range sources[c*chunkSize : min((c+1)*chunkSize, len(sources))] {
+					stack := NewStack[K]()
+
+					// reset everything
+					for _, w := range nodes {
+						p[w] = p[w][:0]
+						sigma[w] = 0
+						d[w] = -1
+					}
+					sigma[n] = 1
+					d[n] = 0
+
+					queue.Push(n)
+					for queue.Len() > 0 {
+						v := queue.Pop()
+
+						stack.Push(v)
+
+						for _, edge := range g.Neighbors(v) {
+							neighbor := edge.To
+							// neighbor found for first time
+							if d[neighbor] < 0 {
+								queue.Push(neighbor)
+								d[neighbor] = d[v] + 1
+							}
+							// shortest path to neighbor from v
+							if d[neighbor] == d[v]+1 {
+								sigma[neighbor] += sigma[v]
+								p[neighbor] = append(p[neighbor], v)
+							}
+						}
+					}
+
+					for _, v := range nodes {
+						delta[v] = 0
+					}
+
+					results[c].accumulate(n, stack, p, delta, sigma)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	merged := newResult()
+	for _, r := range results {
+		merged.merge(r.result(), scale)
+	}
+	return merged.result()
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestBetweeness(t *testing.T) {
+	// a - b - c, so every path between a and c goes through b
+	cMap := ConnectionMap[string]{
+		"a": {"b": 1},
+		"b": {"a": 1, "c": 1},
+		"c": {"b": 1},
+	}
+
+	cb := Betweeness[string](cMap)
+	if cb["b"] != 2 || cb["a"] != 0 || cb["c"] != 0 {
+		t.Errorf("got betweeness %v", cb)
+	}
+
+	ecb := cMap.EdgeBetweeness()
+	if ecb["a"]["b"] != 2 || ecb["b"]["c"] != 2 {
+		t.Errorf("got edge betweeness %v", ecb)
+	}
+}
+
+func TestBetweenessGrid(t *testing.T) {
+	// on a path through a grid, the middle node is on more shortest paths than any other
+	cMap := gridConnectionMap(5)
+	cb := cMap.Betweeness()
+
+	middle := NewCoordinate(2, 2)
+	for node, c := range cb {
+		if node != middle && c >= cb[middle] {
+			t.Errorf("%s has betweeness %f, more than the middle's %f", node, c, cb[middle])
+		}
+	}
+}
+
+func TestApproxBetweeness(t *testing.T) {
+	cMap := barbellConnectionMap(20)
+
+	// using every node as a pivot is the same as the exact answer
+	exact := cMap.EdgeBetweeness()
+	all := cMap.ApproxEdgeBetweeness(len(cMap), 1)
+	for v, neighbors := range exact {
+		for w, c := range neighbors {
+			if math.Abs(all[v][w]-c) > 1e-9 {
+				t.Errorf("edge %d-%d: got %f, want %f", v, w, all[v][w], c)
+			}
+		}
+	}
+
+	first := cMap.ApproxEdgeBetweeness(8, 16)
+	second := cMap.ApproxEdgeBetweeness(8, 16)
+	for v, neighbors := range first {
+		for w, c := range neighbors {
+			if math.Abs(second[v][w]-c) > 1e-9 {
+				t.Errorf("edge %d-%d: the same seed gave %f and %f", v, w, c, second[v][w])
+			}
+		}
+	}
+
+	// the edge joining the two halves is still the most important by far
+	bridge := first[0][20] + first[20][0]
+	for v, neighbors := range first {
+		for w, c := range neighbors {
+			if (v == 0 && w == 20) || (v == 20 && w == 0) {
+				continue
+			}
+			if c >= bridge {
+				t.Errorf("edge %d-%d has %f, more than the bridge's %f", v, w, c, bridge)
+			}
+		}
+	}
+
+	nodes := cMap.ApproxBetweeness(8, 16)
+	if nodes[0] <= nodes[1] || nodes[20] <= nodes[21] {
+		t.Errorf("expected the bridge's ends to have the most betweeness, got %v", nodes)
+	}
+}
+
+func TestApproxBetweenessSameOnAnyMachine(t *testing.T) {
+	cMap := barbellConnectionMap(20)
+
+	procs := runtime.GOMAXPROCS(1)
+	defer runtime.GOMAXPROCS(procs)
+	single := cMap.ApproxEdgeBetweeness(9, 1)
+
+	runtime.GOMAXPROCS(8)
+	parallel := cMap.ApproxEdgeBetweeness(9, 1)
+
+	// the scaled results are added up in the same order no matter how many goroutines there are
+	for v, neighbors := range single {
+		for w, c := range neighbors {
+			if parallel[v][w] != c {
+				t.Errorf("edge %d-%d: got %v with 1 goroutine and %v with 8", v, w, c, parallel[v][w])
+			}
+		}
+	}
+}
+
+// barbellConnectionMap makes two groups of size nodes where everything is connected, and a single
+// edge between node 0 and node size joining them
+func barbellConnectionMap(size int) ConnectionMap[int] {
+	cMap := ConnectionMap[int]{}
+	for i := 0; i < size*2; i++ {
+		cMap[i] = map[int]int{}
+	}
+	for offset := 0; offset <= size; offset += size {
+		for a := offset; a < offset+size; a++ {
+			for b := offset; b < offset+size; b++ {
+				if a != b {
+					cMap[a][b] = 1
+				}
+			}
+		}
+	}
+	cMap[0][size] = 1
+	cMap[size][0] = 1
+	return cMap
+}
+
+func BenchmarkEdgeBetweeness(b *testing.B) {
+	for _, size := range []int{5, 10, 20} {
+		cMap := gridConnectionMap(size)
+
+		b.Run(fmt.Sprintf("grid%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				cMap.EdgeBetweeness()
+			}
+		})
+
+		b.Run(fmt.Sprintf("approx-grid%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				cMap.ApproxEdgeBetweeness(size, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// CheckedAdd returns ErrOverflow instead of wrapping around
+func CheckedAdd(a, b int) (int, error) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, fmt.Errorf("%d + %d: %w", a, b, ErrOverflow)
+	}
+	return c, nil
+}
+
+// CheckedSub returns ErrOverflow instead of wrapping around
+func CheckedSub(a, b int) (int, error) {
+	c := a - b
+	if (b > 0 && c > a) || (b < 0 && c < a) {
+		return 0, fmt.Errorf("%d - %d: %w", a, b, ErrOverflow)
+	}
+	return c, nil
+}
+
+// CheckedMul returns ErrOverflow instead of wrapping around
+func CheckedMul(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+
+	c := a * b
+	if c/b != a || (a == math.MinInt && b == -1) {
+		return 0, fmt.Errorf("%d * %d: %w", a, b, ErrOverflow)
+	}
+	return c, nil
+}
+
+// CheckedSum adds up nums, returning ErrOverflow if the sum ever doesn't fit in an int
+func CheckedSum(nums []int) (int, error) {
+	sum := 0
+	for _, n := range nums {
+		var err error
+		sum, err = CheckedAdd(sum, n)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return sum, nil
+}
+
+// CheckedProduct multiplies nums, returning ErrOverflow if the product ever doesn't fit in an int
+func CheckedProduct(nums []int) (int, error) {
+	product := 1
+	for _, n := range nums {
+		var err error
+		product, err = CheckedMul(product, n)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return product, nil
+}
+
+// BigSum is CheckedSum for when the sum might not fit in an int
+func BigSum(nums []int) *big.Int {
+	sum := new(big.Int)
+	for _, n := range nums {
+		sum.Add(sum, big.NewInt(int64(n)))
+	}
+	return sum
+}
+
+// BigProduct is CheckedProduct for when the product might not fit in an int
+func BigProduct(nums []int) *big.Int {
+	product := big.NewInt(1)
+	for _, n := range nums {
+		product.Mul(product, big.NewInt(int64(n)))
+	}
+	return product
+}
+
+// BigLeastCommonMultiple is LeastCommonMultiple for when the lcm might not fit in an int
+func BigLeastCommonMultiple(nums []int) *big.Int {
+	lcm := big.NewInt(1)
+	gcd := new(big.Int)
+	for _, n := range nums {
+		if n == 0 {
+			return new(big.Int)
+		}
+
+		bigN := big.NewInt(int64(n))
+		bigN.Abs(bigN)
+
+		gcd.GCD(nil, nil, lcm, bigN)
+		lcm.Mul(lcm.Div(lcm, gcd), bigN)
+	}
+	return lcm
+}
+
+// BigToInt returns ErrOverflow if n doesn't fit in an int
+func BigToInt(n *big.Int) (int, error) {
+	if !n.IsInt64() || n.Int64() > math.MaxInt || n.Int64() < math.MinInt {
+		return 0, fmt.Errorf("%s: %w", n, ErrOverflow)
+	}
+	return int(n.Int64()), nil
+}
+
+// RatToInt returns an error if r isn't a whole number that fits in an int
+func RatToInt(r *big.Rat) (int, error) {
+	if !r.IsInt() {
+		return 0, fmt.Errorf("%s is not a whole number", r.RatString())
+	}
+	return BigToInt(r.Num())
+}
+
+// SolveLinearSystem solves the system of equations in the augmented matrix m, where each row is
+// the coefficients of one equation followed by its constant. The math is done with exact rationals,
+// so large coefficients don't lose any precision.
+func SolveLinearSystem(m [][]int) ([]*big.Rat, error) {
+	return SolveBigLinearSystem(SliceMap(m, func(row []int) []*big.Int {
+		return SliceMap(row, func(v int) *big.Int { return big.NewInt(int64(v)) })
+	}))
+}
+
+// SolveBigLinearSystem is SolveLinearSystem for coefficients that might not fit in an int
+func SolveBigLinearSystem(m [][]*big.Int) ([]*big.Rat, error) {
+	n := len(m)
+	rows := make([][]*big.Rat, n)
+	for i, row := range m {
+		if len(row) != n+1 {
+			return nil, fmt.Errorf("row %d has %d values, expected %d", i, len(row), n+1)
+		}
+
+		rows[i] = make([]*big.Rat, len(row))
+		for j, v := range row {
+			rows[i][j] = new(big.Rat).SetInt(v)
+		}
+	}
+
+	tmp := new(big.Rat)
+	for col := 0; col < n; col++ {
+		pivot := -1
+		for i := col; i < n; i++ {
+			if rows[i][col].Sign() != 0 {
+				pivot = i
+				break
+			}
+		}
+		if pivot == -1 {
+			return nil, fmt.Errorf("system has no single solution: %w", ErrNoSolution)
+		}
+		rows[col], rows[pivot] = rows[pivot], rows[col]
+
+		factor := new(big.Rat).Set(rows[col][col])
+		for j := col; j <= n; j++ {
+			rows[col][j].Quo(rows[col][j], factor)
+		}
+
+		for i := 0; i < n; i++ {
+			if i == col || rows[i][col].Sign() == 0 {
+				continue
+			}
+
+			factor.Set(rows[i][col])
+			for j := col; j <= n; j++ {
+				rows[i][j].Sub(rows[i][j], tmp.Mul(factor, rows[col][j]))
+			}
+		}
+	}
+
+	solution := make([]*big.Rat, n)
+	for i, row := range rows {
+		solution[i] = row[n]
+	}
+	return solution, nil
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestCheckedArithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        func(a, b int) (int, error)
+		a, b     int
+		want     int
+		overflow bool
+	}{
+		{"add", CheckedAdd, 2, 3, 5, false},
+		{"add", CheckedAdd, math.MaxInt, 1, 0, true},
+		{"add", CheckedAdd, math.MinInt, -1, 0, true},
+		{"add", CheckedAdd, math.MaxInt, math.MinInt, -1, false},
+		{"sub", CheckedSub, 2, 3, -1, false},
+		{"sub", CheckedSub, math.MinInt, 1, 0, true},
+		{"sub", CheckedSub, 0, math.MinInt, 0, true},
+		{"mul", CheckedMul, -4, 6, -24, false},
+		{"mul", CheckedMul, math.MaxInt, 0, 0, false},
+		{"mul", CheckedMul, math.MaxInt/2 + 1, 2, 0, true},
+		{"mul", CheckedMul, math.MinInt, -1, 0, true},
+		{"mul", CheckedMul, -1, math.MinInt, 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.f(tt.a, tt.b)
+		if tt.overflow {
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("%s(%d, %d): expected ErrOverflow, got %d, %v", tt.name, tt.a, tt.b, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s(%d, %d) = %d, %v, want %d", tt.name, tt.a, tt.b, got, err, tt.want)
+		}
+	}
+}
+
+func TestCheckedSumAndProduct(t *testing.T) {
+	if sum, err := CheckedSum([]int{1, 2, 3}); err != nil || sum != 6 {
+		t.Errorf("CheckedSum = %d, %v, want 6", sum, err)
+	}
+	if _, err := CheckedSum([]int{math.MaxInt, 1}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", err)
+	}
+	if product, err := CheckedProduct([]int{2, 3, 4}); err != nil || product != 24 {
+		t.Errorf("CheckedProduct = %d, %v, want 24", product, err)
+	}
+	if _, err := CheckedProduct([]int{1 << 40, 1 << 40}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", err)
+	}
+
+	want, _ := new(big.Int).SetString("1208925819614629174706176", 10)
+	if got := BigProduct([]int{1 << 40, 1 << 40}); got.Cmp(want) != 0 {
+		t.Errorf("BigProduct = %s, want %s", got, want)
+	}
+	if got := BigSum([]int{math.MaxInt, math.MaxInt}); got.String() != "18446744073709551614" {
+		t.Errorf("BigSum = %s", got)
+	}
+}
+
+func TestBigLeastCommonMultiple(t *testing.T) {
+	if got := BigLeastCommonMultiple([]int{4, 6}); got.Int64() != 12 {
+		t.Errorf("BigLeastCommonMultiple(4, 6) = %s, want 12", got)
+	}
+
+	primes := []int{1000000007, 998244353, 1000000009}
+	got := BigLeastCommonMultiple(primes)
+	if got.Cmp(BigProduct(primes)) != 0 {
+		t.Errorf("BigLeastCommonMultiple(%v) = %s, want their product", primes, got)
+	}
+	if _, err := BigToInt(got); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow converting %s, got %v", got, err)
+	}
+}
+
+func TestSolveLinearSystem(t *testing.T) {
+	// x + y + z = 6, 2y + 5z = -4, 2x + 5y - z = 27
+	solution, err := SolveLinearSystem([][]int{
+		{1, 1, 1, 6},
+		{0, 2, 5, -4},
+		{2, 5, -1, 27},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i, want := range []int{5, 3, -2} {
+		got, err := RatToInt(solution[i])
+		if err != nil || got != want {
+			t.Errorf("x%d = %s, want %d", i, solution[i].RatString(), want)
+		}
+	}
+
+	// the second equation is just the first doubled
+	_, err = SolveLinearSystem([][]int{
+		{1, 2, 3},
+		{2, 4, 6},
+	})
+	if !errors.Is(err, ErrNoSolution) {
+		t.Errorf("expected ErrNoSolution, got %v", err)
+	}
+
+	if _, err := RatToInt(big.NewRat(1, 2)); err == nil {
+		t.Error("expected error converting 1/2 to an int")
+	}
+}
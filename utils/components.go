@@ -0,0 +1,195 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrCycle = errors.New("graph has a cycle")
+)
+
+// CycleError is returned when a graph has a cycle where it can't have one
+type CycleError[K comparable] struct {
+	// Cycle is the nodes around one of the cycles, starting and ending with the same node
+	Cycle []K
+}
+
+func (e CycleError[K]) Error() string {
+	return fmt.Sprintf("%s: %s", ErrCycle, strings.Join(SliceMap(e.Cycle, func(n K) string {
+		return fmt.Sprint(n)
+	}), " -> "))
+}
+
+func (e CycleError[K]) Unwrap() error {
+	return ErrCycle
+}
+
+// OutDegree is how many edges leave node
+func (cMap ConnectionMap[K]) OutDegree(node K) int {
+	return len(cMap[node])
+}
+
+// InDegree is how many edges lead to node
+func (cMap ConnectionMap[K]) InDegree(node K) int {
+	return len(cMap.InNeighbors(node))
+}
+
+// InNeighbors are the nodes with an edge leading to node
+func (cMap ConnectionMap[K]) InNeighbors(node K) []K {
+	neighbors := []K{}
+	for n, edges := range cMap {
+		if _, ok := edges[node]; ok {
+			neighbors = append(neighbors, n)
+		}
+	}
+	return neighbors
+}
+
+// InDegrees is how many edges lead to every node, including ones that are only ever edge destinations
+func (cMap ConnectionMap[K]) InDegrees() map[K]int {
+	degrees := make(map[K]int, len(cMap))
+	for node, edges := range cMap {
+		if _, ok := degrees[node]; !ok {
+			degrees[node] = 0
+		}
+		for neighbor := range edges {
+			degrees[neighbor]++
+		}
+	}
+	return degrees
+}
+
+// Components splits the graph into groups of nodes that are connected to each other, ignoring which
+// way the edges go
+func (cMap ConnectionMap[K]) Components() [][]K {
+	uf := NewUnionFind[K]()
+	for node, edges := range cMap {
+		uf.Add(node)
+		for neighbor := range edges {
+			uf.Union(node, neighbor)
+		}
+	}
+	return uf.Groups()
+}
+
+// StronglyConnectedComponents splits the graph into groups of nodes that can all reach each other
+// following the edges, using Tarjan's algorithm. The groups come out in reverse topological order,
+// so no group has an edge to one that comes after it.
+func (cMap ConnectionMap[K]) StronglyConnectedComponents() [][]K {
+	index := map[K]int{}
+	lowLink := map[K]int{}
+	onStack := map[K]bool{}
+	stack := NewStack[K]()
+	components := [][]K{}
+
+	var strongConnect func(node K)
+	strongConnect = func(node K) {
+		index[node] = len(index)
+		lowLink[node] = index[node]
+		stack.Push(node)
+		onStack[node] = true
+
+		for neighbor := range cMap[node] {
+			if _, ok := index[neighbor]; !ok {
+				strongConnect(neighbor)
+				lowLink[node] = min(lowLink[node], lowLink[neighbor])
+			} else if onStack[neighbor] {
+				lowLink[node] = min(lowLink[node], index[neighbor])
+			}
+		}
+
+		// node is the first one found in its component, so everything above it on the stack is in it
+		if lowLink[node] == index[node] {
+			component := []K{}
+			for {
+				n := stack.Pop()
+				onStack[n] = false
+				component = append(component, n)
+				if n == node {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	for node := range cMap.InDegrees() {
+		if _, ok := index[node]; !ok {
+			strongConnect(node)
+		}
+	}
+
+	return components
+}
+
+// TopologicalSort orders the nodes so every edge goes from a node to one after it, using Kahn's
+// algorithm. If the graph has a cycle, there's no such order and a CycleError is returned.
+func (cMap ConnectionMap[K]) TopologicalSort() ([]K, error) {
+	inDegrees := cMap.InDegrees()
+
+	queue := NewQueue[K]()
+	for node, degree := range inDegrees {
+		if degree == 0 {
+			queue.Push(node)
+		}
+	}
+
+	order := make([]K, 0, len(inDegrees))
+	for queue.Len() > 0 {
+		node := queue.Pop()
+		order = append(order, node)
+
+		for neighbor := range cMap[node] {
+			inDegrees[neighbor]--
+			if inDegrees[neighbor] == 0 {
+				queue.Push(neighbor)
+			}
+		}
+	}
+
+	if len(order) == len(inDegrees) {
+		return order, nil
+	}
+
+	return nil, CycleError[K]{Cycle: cMap.findCycle(inDegrees)}
+}
+
+// findCycle finds a cycle among the nodes Kahn's algorithm couldn't get to. Every one of them still
+// has an edge coming in from another one of them, so walking backwards along those edges has to
+// eventually come back around to a node it's already seen.
+func (cMap ConnectionMap[K]) findCycle(inDegrees map[K]int) []K {
+	var start K
+	for node, degree := range inDegrees {
+		if degree > 0 {
+			start = node
+			break
+		}
+	}
+
+	from := func(node K) K {
+		for n, edges := range cMap {
+			if _, ok := edges[node]; ok && inDegrees[n] > 0 {
+				return n
+			}
+		}
+		panic(fmt.Sprintf("no edge into %v from the rest of the cycle", node))
+	}
+
+	seen := map[K]int{}
+	walk := []K{}
+	node := start
+	for {
+		if i, ok := seen[node]; ok {
+			cycle := slices.Clone(walk[i:])
+			cycle = append(cycle, node)
+			slices.Reverse(cycle)
+			return cycle
+		}
+		seen[node] = len(walk)
+		walk = append(walk, node)
+		node = from(node)
+	}
+}
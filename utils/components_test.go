@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func sortedGroups(groups [][]string) [][]string {
+	for _, g := range groups {
+		slices.Sort(g)
+	}
+	slices.SortFunc(groups, func(a, b []string) int {
+		return slices.Compare(a, b)
+	})
+	return groups
+}
+
+func TestComponents(t *testing.T) {
+	// edges only going one way still join nodes together
+	cMap := ConnectionMap[string]{
+		"a": {"b": 1},
+		"c": {"b": 1},
+		"d": {"e": 1},
+		"f": {},
+	}
+
+	got := sortedGroups(cMap.Components())
+	want := [][]string{{"a", "b", "c"}, {"d", "e"}, {"f"}}
+	if !slices.EqualFunc(got, want, slices.Equal[[]string]) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	// a -> b -> c -> a, c -> d -> e -> d, f on its own
+	cMap := ConnectionMap[string]{
+		"a": {"b": 1},
+		"b": {"c": 1},
+		"c": {"a": 1, "d": 1},
+		"d": {"e": 1},
+		"e": {"d": 1},
+		"f": {},
+	}
+
+	components := cMap.StronglyConnectedComponents()
+	got := sortedGroups(slices.Clone(components))
+	want := [][]string{{"a", "b", "c"}, {"d", "e"}, {"f"}}
+	if !slices.EqualFunc(got, want, slices.Equal[[]string]) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// {d, e} can't reach {a, b, c}, so it has to come out first
+	dIdx := slices.IndexFunc(components, func(c []string) bool { return slices.Contains(c, "d") })
+	aIdx := slices.IndexFunc(components, func(c []string) bool { return slices.Contains(c, "a") })
+	if dIdx > aIdx {
+		t.Errorf("expected components in reverse topological order, got %v", components)
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	cMap := ConnectionMap[string]{
+		"shirt":  {"tie": 1, "belt": 1},
+		"tie":    {"jacket": 1},
+		"pants":  {"shoes": 1, "belt": 1},
+		"belt":   {"jacket": 1},
+		"socks":  {"shoes": 1},
+		"watch":  {},
+		"jacket": {},
+	}
+
+	order, err := cMap.TopologicalSort()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(order) != 8 {
+		t.Errorf("got %d nodes, want 8: %v", len(order), order)
+	}
+	for node, edges := range cMap {
+		for neighbor := range edges {
+			if slices.Index(order, node) > slices.Index(order, neighbor) {
+				t.Errorf("%s should come before %s in %v", node, neighbor, order)
+			}
+		}
+	}
+
+	// a -> b -> c -> d -> b
+	cMap = ConnectionMap[string]{
+		"a": {"b": 1},
+		"b": {"c": 1},
+		"c": {"d": 1},
+		"d": {"b": 1},
+	}
+	_, err = cMap.TopologicalSort()
+	if !errors.Is(err, ErrCycle) {
+		t.Fatalf("expected ErrCycle, got %v", err)
+	}
+
+	var cycleErr CycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("expected a CycleError, got %T", err)
+	}
+	cycle := cycleErr.Cycle
+	if len(cycle) != 4 || cycle[0] != cycle[len(cycle)-1] {
+		t.Fatalf("got cycle %v", cycle)
+	}
+	for i := 1; i < len(cycle); i++ {
+		if _, ok := cMap[cycle[i-1]][cycle[i]]; !ok {
+			t.Errorf("cycle %v uses an edge that doesn't exist", cycle)
+		}
+	}
+}
+
+func TestDegrees(t *testing.T) {
+	cMap := ConnectionMap[string]{
+		"a": {"b": 1, "c": 1},
+		"b": {"c": 1},
+	}
+
+	if cMap.OutDegree("a") != 2 || cMap.OutDegree("c") != 0 {
+		t.Errorf("got out degrees %d and %d", cMap.OutDegree("a"), cMap.OutDegree("c"))
+	}
+	if cMap.InDegree("c") != 2 || cMap.InDegree("a") != 0 {
+		t.Errorf("got in degrees %d and %d", cMap.InDegree("c"), cMap.InDegree("a"))
+	}
+
+	want := map[string]int{"a": 0, "b": 1, "c": 2}
+	if got := cMap.InDegrees(); len(got) != len(want) || got["a"] != 0 || got["b"] != 1 || got["c"] != 2 {
+		t.Errorf("got in degrees %v, want %v", got, want)
+	}
+
+	in := cMap.InNeighbors("c")
+	slices.Sort(in)
+	if !slices.Equal(in, []string{"a", "b"}) {
+		t.Errorf("got in neighbors %v", in)
+	}
+}
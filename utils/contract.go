@@ -0,0 +1,137 @@
+package utils
+
+// ChainMerge is which chain ContractChains keeps when more than one leads between the same two nodes
+type ChainMerge int
+
+const (
+	// KeepShortestChain keeps the shortest paths through the graph the same
+	KeepShortestChain ChainMerge = iota
+	// KeepLongestChain keeps the longest simple paths through the graph the same
+	KeepLongestChain
+)
+
+// replaces is if a chain of length weight should be used over one of length existing
+func (m ChainMerge) replaces(existing, weight int) bool {
+	if m == KeepLongestChain {
+		return weight > existing
+	}
+	return weight < existing
+}
+
+// ContractChains replaces every chain of nodes that just lead from one node to the next with a single
+// edge, weighted by the length of the chain. A node is part of a chain when keep is false for it and it
+// only connects to two other nodes, u and w, in a way that it can be passed through, like u -> node -> w
+// or u <-> node <-> w. An edge that can only lead back the way it came, like node -> u when the only way
+// in is from u, is dropped, since a path going through the node could never use it. Everything else is
+// kept, including nodes with self-loops, and a chain that leads back to where it started becomes a
+// self-loop. A cycle made up of nothing but chain nodes keeps one of its nodes so it isn't lost.
+//
+// If there's more than one chain between the same two nodes, merge picks which one is used. Along with
+// the new graph, the path each new edge stands for is returned, including the nodes at both ends.
+func (cMap ConnectionMap[K]) ContractChains(keep func(K) bool, merge ChainMerge) (ConnectionMap[K], map[K]map[K][]K) {
+	in := map[K]map[K]bool{}
+	for node, neighbors := range cMap {
+		if _, ok := in[node]; !ok {
+			in[node] = map[K]bool{}
+		}
+		for neighbor := range neighbors {
+			if _, ok := in[neighbor]; !ok {
+				in[neighbor] = map[K]bool{}
+			}
+			in[neighbor][node] = true
+		}
+	}
+
+	isChain := func(node K) bool {
+		if keep(node) {
+			return false
+		}
+		if _, ok := cMap[node][node]; ok {
+			return false
+		}
+
+		neighbors := map[K]bool{}
+		for n := range cMap[node] {
+			neighbors[n] = true
+		}
+		for n := range in[node] {
+			neighbors[n] = true
+		}
+		if len(neighbors) != 2 {
+			return false
+		}
+
+		// it has to be possible to go through it in at least one direction
+		for u := range neighbors {
+			for w := range neighbors {
+				if _, ok := cMap[node][w]; ok && u != w && in[node][u] {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	kept := map[K]bool{}
+	for node := range in {
+		if !isChain(node) {
+			kept[node] = true
+		}
+	}
+
+	newCMap := ConnectionMap[K]{}
+	paths := map[K]map[K][]K{}
+	walked := map[K]bool{}
+
+	walkFrom := func(start K) {
+		newCMap[start] = map[K]int{}
+		paths[start] = map[K][]K{}
+
+	walks:
+		for first, weight := range cMap[start] {
+			path := []K{start}
+			prev, cur := start, first
+
+			for !kept[cur] {
+				walked[cur] = true
+				path = append(path, cur)
+
+				// a chain node only has one other neighbor to go on to
+				next, ok := prev, false
+				for neighbor := range cMap[cur] {
+					if neighbor != prev {
+						next, ok = neighbor, true
+					}
+				}
+				if !ok {
+					// the chain can't be passed through this way
+					continue walks
+				}
+				weight += cMap[cur][next]
+				prev, cur = cur, next
+			}
+			path = append(path, cur)
+
+			if existing, ok := newCMap[start][cur]; ok && !merge.replaces(existing, weight) {
+				continue
+			}
+			newCMap[start][cur] = weight
+			paths[start][cur] = path
+		}
+	}
+
+	for node := range kept {
+		walkFrom(node)
+	}
+
+	// anything left over must be in a cycle of chain nodes that no kept node leads into
+	for node := range in {
+		if kept[node] || walked[node] {
+			continue
+		}
+		kept[node] = true
+		walkFrom(node)
+	}
+
+	return newCMap, paths
+}
@@ -0,0 +1,273 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestContractChains(t *testing.T) {
+	tests := []struct {
+		name      string
+		cMap      ConnectionMap[string]
+		keep      []string
+		merge     ChainMerge
+		want      ConnectionMap[string]
+		wantPaths map[string]map[string][]string
+	}{
+		{
+			name: "undirected",
+			// a - b - c - d, with a branch off of c to e
+			cMap: ConnectionMap[string]{
+				"a": {"b": 1},
+				"b": {"a": 1, "c": 2},
+				"c": {"b": 2, "d": 3, "e": 4},
+				"d": {"c": 3},
+				"e": {"c": 4},
+			},
+			want: ConnectionMap[string]{
+				"a": {"c": 3},
+				"c": {"a": 3, "d": 3, "e": 4},
+				"d": {"c": 3},
+				"e": {"c": 4},
+			},
+			wantPaths: map[string]map[string][]string{
+				"a": {"c": {"a", "b", "c"}},
+				"c": {"a": {"c", "b", "a"}, "d": {"c", "d"}, "e": {"c", "e"}},
+				"d": {"c": {"d", "c"}},
+				"e": {"c": {"e", "c"}},
+			},
+		},
+		{
+			name: "directed",
+			// a -> b -> c -> d, and d <-> e, so d can only be passed through from c to e
+			cMap: ConnectionMap[string]{
+				"a": {"b": 1},
+				"b": {"c": 1},
+				"c": {"d": 1},
+				"d": {"e": 5},
+				"e": {"d": 5},
+			},
+			want: ConnectionMap[string]{
+				"a": {"e": 8},
+				"e": {},
+			},
+			wantPaths: map[string]map[string][]string{
+				"a": {"e": {"a", "b", "c", "d", "e"}},
+				"e": {},
+			},
+		},
+		{
+			name: "keep",
+			cMap: ConnectionMap[string]{
+				"a": {"b": 1},
+				"b": {"a": 1, "c": 1},
+				"c": {"b": 1, "d": 1},
+				"d": {"c": 1},
+			},
+			keep: []string{"b"},
+			want: ConnectionMap[string]{
+				"a": {"b": 1},
+				"b": {"a": 1, "d": 2},
+				"d": {"b": 2},
+			},
+			wantPaths: map[string]map[string][]string{
+				"a": {"b": {"a", "b"}},
+				"b": {"a": {"b", "a"}, "d": {"b", "c", "d"}},
+				"d": {"b": {"d", "c", "b"}},
+			},
+		},
+		{
+			name: "mixed directions",
+			// a <-> b -> c <-> d, like a path with a slope in the middle of it
+			cMap: ConnectionMap[string]{
+				"a": {"b": 1},
+				"b": {"a": 1, "c": 1},
+				"c": {"d": 1},
+				"d": {"c": 1},
+			},
+			keep: []string{"a", "d"},
+			want: ConnectionMap[string]{
+				"a": {"d": 3},
+				"d": {},
+			},
+			wantPaths: map[string]map[string][]string{
+				"a": {"d": {"a", "b", "c", "d"}},
+				"d": {},
+			},
+		},
+		{
+			name: "loop back to start",
+			// a -> b -> c -> a, with a -> d
+			cMap: ConnectionMap[string]{
+				"a": {"b": 1, "d": 1},
+				"b": {"c": 2},
+				"c": {"a": 3},
+			},
+			want: ConnectionMap[string]{
+				"a": {"a": 6, "d": 1},
+				"d": {},
+			},
+			wantPaths: map[string]map[string][]string{
+				"a": {"a": {"a", "b", "c", "a"}, "d": {"a", "d"}},
+				"d": {},
+			},
+		},
+		{
+			name: "self-loop",
+			// b has a self-loop, so it can't be contracted
+			cMap: ConnectionMap[string]{
+				"a": {"b": 1},
+				"b": {"b": 1, "c": 1},
+				"c": {},
+			},
+			want: ConnectionMap[string]{
+				"a": {"b": 1},
+				"b": {"b": 1, "c": 1},
+				"c": {},
+			},
+			wantPaths: map[string]map[string][]string{
+				"a": {"b": {"a", "b"}},
+				"b": {"b": {"b", "b"}, "c": {"b", "c"}},
+				"c": {},
+			},
+		},
+		{
+			name: "parallel chains",
+			// two ways from a to d, a - b - d and a - c - d
+			cMap: ConnectionMap[string]{
+				"a": {"b": 1, "c": 5},
+				"b": {"a": 1, "d": 1},
+				"c": {"a": 5, "d": 5},
+				"d": {"b": 1, "c": 5},
+			},
+			keep: []string{"a", "d"},
+			want: ConnectionMap[string]{
+				"a": {"d": 2},
+				"d": {"a": 2},
+			},
+			wantPaths: map[string]map[string][]string{
+				"a": {"d": {"a", "b", "d"}},
+				"d": {"a": {"d", "b", "a"}},
+			},
+		},
+		{
+			name: "parallel chains keeping the longest",
+			// three ways from a to d, a - d, a - b - d and a - c - e - d
+			cMap: ConnectionMap[string]{
+				"a": {"b": 1, "c": 2, "d": 1},
+				"b": {"a": 1, "d": 1},
+				"c": {"a": 2, "e": 2},
+				"e": {"c": 2, "d": 2},
+				"d": {"a": 1, "b": 1, "e": 2},
+			},
+			keep:  []string{"a", "d"},
+			merge: KeepLongestChain,
+			want: ConnectionMap[string]{
+				"a": {"d": 6},
+				"d": {"a": 6},
+			},
+			wantPaths: map[string]map[string][]string{
+				"a": {"d": {"a", "c", "e", "d"}},
+				"d": {"a": {"d", "e", "c", "a"}},
+			},
+		},
+		{
+			name: "chain shorter than a direct edge",
+			// a - d directly is longer than going through b
+			cMap: ConnectionMap[string]{
+				"a": {"b": 1, "d": 5},
+				"b": {"a": 1, "d": 1},
+				"d": {"a": 5, "b": 1},
+			},
+			keep: []string{"a", "d"},
+			want: ConnectionMap[string]{
+				"a": {"d": 2},
+				"d": {"a": 2},
+			},
+			wantPaths: map[string]map[string][]string{
+				"a": {"d": {"a", "b", "d"}},
+				"d": {"a": {"d", "b", "a"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got, paths := tt.cMap.ContractChains(func(s string) bool { return slices.Contains(tt.keep, s) }, tt.merge)
+
+		if !connectionMapsEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+		if len(paths) != len(tt.wantPaths) {
+			t.Errorf("%s: got paths %v, want %v", tt.name, paths, tt.wantPaths)
+		}
+		for from, tos := range tt.wantPaths {
+			for to, want := range tos {
+				if !slices.Equal(paths[from][to], want) {
+					t.Errorf("%s: path %s -> %s = %v, want %v", tt.name, from, to, paths[from][to], want)
+				}
+			}
+		}
+	}
+}
+
+func TestContractChainsCycle(t *testing.T) {
+	// a ring with nothing to keep still needs one node so the ring doesn't disappear
+	cMap := ConnectionMap[int]{}
+	for i := 0; i < 5; i++ {
+		cMap[i] = map[int]int{(i + 1) % 5: 1, (i + 4) % 5: 1}
+	}
+
+	got, paths := cMap.ContractChains(func(int) bool { return false }, KeepShortestChain)
+	if len(got) != 1 {
+		t.Fatalf("expected a single node, got %v", got)
+	}
+	for node, neighbors := range got {
+		if neighbors[node] != 5 {
+			t.Errorf("expected a self-loop of length 5, got %v", got)
+		}
+		if path := paths[node][node]; len(path) != 6 {
+			t.Errorf("expected the path to go around the whole ring, got %v", path)
+		}
+	}
+}
+
+func TestContractChainsKeepsLongestPath(t *testing.T) {
+	cMap := gridConnectionMap(5)
+	// block off most of the grid so it's mostly corridors
+	for _, coor := range []Coordinate{{1, 1}, {3, 1}, {1, 3}, {3, 3}} {
+		for neighbor := range cMap[coor] {
+			delete(cMap[neighbor], coor)
+		}
+		delete(cMap, coor)
+	}
+
+	source, dest := NewCoordinate(0, 0), NewCoordinate(4, 4)
+	contracted, _ := cMap.ContractChains(func(c Coordinate) bool { return c == source || c == dest }, KeepLongestChain)
+	if len(contracted) >= len(cMap) {
+		t.Errorf("expected fewer nodes after contracting, got %d from %d", len(contracted), len(cMap))
+	}
+
+	want, _, _ := LongestSimplePath[Coordinate](cMap, source, dest)
+	got, _, err := LongestSimplePath[Coordinate](contracted, source, dest)
+	if err != nil || got != want {
+		t.Errorf("longest path after contracting = %d, %v, want %d", got, err, want)
+	}
+}
+
+func connectionMapsEqual[K comparable](a, b ConnectionMap[K]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for node, neighbors := range a {
+		other, ok := b[node]
+		if !ok || len(other) != len(neighbors) {
+			return false
+		}
+		for neighbor, weight := range neighbors {
+			if w, ok := other[neighbor]; !ok || w != weight {
+				return false
+			}
+		}
+	}
+	return true
+}
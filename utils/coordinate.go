@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"math"
 )
 
 type Coordinate struct {
@@ -29,41 +30,82 @@ func (c Coordinate) String() string {
 	return fmt.Sprintf("(%d, %d)", c.X, c.Y)
 }
 
+// StepsToCoordinate is how many steps it takes to get to c2 moving up, down, left or right
 func (c Coordinate) StepsToCoordinate(c2 Coordinate) int {
-	xDiff := c.X - c2.X
-	if xDiff < 0 {
-		xDiff *= -1
-	}
+	return c.Manhattan(c2)
+}
 
-	yDiff := c.Y - c2.Y
-	if yDiff < 0 {
-		yDiff *= -1
-	}
+func (c Coordinate) Scale(k int) Coordinate {
+	return NewCoordinate(c.X*k, c.Y*k)
+}
 
-	return xDiff + yDiff
+func (c Coordinate) Dot(c2 Coordinate) int {
+	return c.X*c2.X + c.Y*c2.Y
 }
 
-type Direction Coordinate
+// Cross is the z part of the cross product, treating both as 3D vectors with z = 0. It's positive when
+// c2 is counterclockwise from c, going by the usual x right and y up.
+func (c Coordinate) Cross(c2 Coordinate) int {
+	return c.X*c2.Y - c.Y*c2.X
+}
 
-var (
-	UP    = Direction(NewCoordinate(0, -1))
-	DOWN  = Direction(NewCoordinate(0, 1))
-	LEFT  = Direction(NewCoordinate(-1, 0))
-	RIGHT = Direction(NewCoordinate(1, 0))
-)
+// Manhattan is the distance to c2 moving only along the axes
+func (c Coordinate) Manhattan(c2 Coordinate) int {
+	return abs(c.X-c2.X) + abs(c.Y-c2.Y)
+}
 
-func (d Direction) String() string {
-	switch d {
-	case UP:
-		return "UP"
-	case DOWN:
-		return "DOWN"
-	case LEFT:
-		return "LEFT"
-	case RIGHT:
-		return "RIGHT"
-	}
-	return ""
+// Chebyshev is the distance to c2 when diagonal moves are allowed too
+func (c Coordinate) Chebyshev(c2 Coordinate) int {
+	return max(abs(c.X-c2.X), abs(c.Y-c2.Y))
+}
+
+// Min is the smallest of each component
+func (c Coordinate) Min(c2 Coordinate) Coordinate {
+	return NewCoordinate(min(c.X, c2.X), min(c.Y, c2.Y))
+}
+
+// Max is the biggest of each component
+func (c Coordinate) Max(c2 Coordinate) Coordinate {
+	return NewCoordinate(max(c.X, c2.X), max(c.Y, c2.Y))
+}
+
+func (c Coordinate) Float() FloatCoordinate {
+	return FloatCoordinate{X: float64(c.X), Y: float64(c.Y)}
+}
+
+// FloatCoordinate is a Coordinate that can be in between the ints
+type FloatCoordinate struct {
+	X float64
+	Y float64
+}
+
+func (c FloatCoordinate) Add(c2 FloatCoordinate) FloatCoordinate {
+	return FloatCoordinate{X: c.X + c2.X, Y: c.Y + c2.Y}
+}
+
+func (c FloatCoordinate) Sub(c2 FloatCoordinate) FloatCoordinate {
+	return FloatCoordinate{X: c.X - c2.X, Y: c.Y - c2.Y}
+}
+
+func (c FloatCoordinate) Scale(k float64) FloatCoordinate {
+	return FloatCoordinate{X: c.X * k, Y: c.Y * k}
+}
+
+func (c FloatCoordinate) Dot(c2 FloatCoordinate) float64 {
+	return c.X*c2.X + c.Y*c2.Y
+}
+
+func (c FloatCoordinate) Cross(c2 FloatCoordinate) float64 {
+	return c.X*c2.Y - c.Y*c2.X
+}
+
+// Round rounds each component to the nearest int
+func (c FloatCoordinate) Round() Coordinate {
+	return NewCoordinate(int(math.Round(c.X)), int(math.Round(c.Y)))
+}
+
+func (c FloatCoordinate) String() string {
+	return fmt.Sprintf("(%v, %v)", c.X, c.Y)
 }
 
 type Number interface {
@@ -88,6 +130,71 @@ func (c Coordinate3D[T]) Translate(x, y, z T) Coordinate3D[T] {
 	return NewCoordinate3D(c.X+x, c.Y+y, c.Z+z)
 }
 
+func (c Coordinate3D[T]) Add(c2 Coordinate3D[T]) Coordinate3D[T] {
+	return NewCoordinate3D(c.X+c2.X, c.Y+c2.Y, c.Z+c2.Z)
+}
+
+func (c Coordinate3D[T]) Sub(c2 Coordinate3D[T]) Coordinate3D[T] {
+	return NewCoordinate3D(c.X-c2.X, c.Y-c2.Y, c.Z-c2.Z)
+}
+
+func (c Coordinate3D[T]) Scale(k T) Coordinate3D[T] {
+	return NewCoordinate3D(c.X*k, c.Y*k, c.Z*k)
+}
+
+func (c Coordinate3D[T]) Dot(c2 Coordinate3D[T]) T {
+	return c.X*c2.X + c.Y*c2.Y + c.Z*c2.Z
+}
+
+// Cross is the vector perpendicular to both c and c2, following the right hand rule
+func (c Coordinate3D[T]) Cross(c2 Coordinate3D[T]) Coordinate3D[T] {
+	return NewCoordinate3D(
+		c.Y*c2.Z-c.Z*c2.Y,
+		c.Z*c2.X-c.X*c2.Z,
+		c.X*c2.Y-c.Y*c2.X,
+	)
+}
+
+// Manhattan is the distance to c2 moving only along the axes
+func (c Coordinate3D[T]) Manhattan(c2 Coordinate3D[T]) T {
+	return abs(c.X-c2.X) + abs(c.Y-c2.Y) + abs(c.Z-c2.Z)
+}
+
+// Chebyshev is the distance to c2 when diagonal moves are allowed too
+func (c Coordinate3D[T]) Chebyshev(c2 Coordinate3D[T]) T {
+	return max(abs(c.X-c2.X), abs(c.Y-c2.Y), abs(c.Z-c2.Z))
+}
+
+// Min is the smallest of each component
+func (c Coordinate3D[T]) Min(c2 Coordinate3D[T]) Coordinate3D[T] {
+	return NewCoordinate3D(min(c.X, c2.X), min(c.Y, c2.Y), min(c.Z, c2.Z))
+}
+
+// Max is the biggest of each component
+func (c Coordinate3D[T]) Max(c2 Coordinate3D[T]) Coordinate3D[T] {
+	return NewCoordinate3D(max(c.X, c2.X), max(c.Y, c2.Y), max(c.Z, c2.Z))
+}
+
+func (c Coordinate3D[T]) Float() Coordinate3D[float64] {
+	return NewCoordinate3D(float64(c.X), float64(c.Y), float64(c.Z))
+}
+
+// Round rounds each component to the nearest int
+func (c Coordinate3D[T]) Round() Coordinate3D[int] {
+	return NewCoordinate3D(
+		int(math.Round(float64(c.X))),
+		int(math.Round(float64(c.Y))),
+		int(math.Round(float64(c.Z))),
+	)
+}
+
 func (c Coordinate3D[T]) String() string {
 	return fmt.Sprintf("(%v, %v, %v)", c.X, c.Y, c.Z)
 }
+
+func abs[T Number](n T) T {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,74 @@
+package utils
+
+import "testing"
+
+func TestCoordinateMath(t *testing.T) {
+	a, b := NewCoordinate(3, -4), NewCoordinate(-1, 2)
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"add", a.Add(b), NewCoordinate(2, -2)},
+		{"sub", a.Sub(b), NewCoordinate(4, -6)},
+		{"scale", a.Scale(-2), NewCoordinate(-6, 8)},
+		{"dot", a.Dot(b), -11},
+		{"cross", a.Cross(b), 2},
+		{"cross reversed", b.Cross(a), -2},
+		{"manhattan", a.Manhattan(b), 10},
+		{"steps", a.StepsToCoordinate(b), 10},
+		{"chebyshev", a.Chebyshev(b), 6},
+		{"min", a.Min(b), NewCoordinate(-1, -4)},
+		{"max", a.Max(b), NewCoordinate(3, 2)},
+		{"float", a.Float(), FloatCoordinate{3, -4}},
+		{"round", FloatCoordinate{2.5, -1.4}.Round(), NewCoordinate(3, -1)},
+		{"float add", a.Float().Add(FloatCoordinate{0.5, 0.5}), FloatCoordinate{3.5, -3.5}},
+		{"float sub", a.Float().Sub(b.Float()), FloatCoordinate{4, -6}},
+		{"float scale", a.Float().Scale(0.5), FloatCoordinate{1.5, -2}},
+		{"float dot", a.Float().Dot(b.Float()), -11.0},
+		{"float cross", a.Float().Cross(b.Float()), 2.0},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestCoordinate3DMath(t *testing.T) {
+	a, b := NewCoordinate3D(1, 2, 3), NewCoordinate3D(-4, 5, -6)
+	f := NewCoordinate3D(0.5, -1.5, 2.0)
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"add", a.Add(b), NewCoordinate3D(-3, 7, -3)},
+		{"sub", a.Sub(b), NewCoordinate3D(5, -3, 9)},
+		{"scale", a.Scale(3), NewCoordinate3D(3, 6, 9)},
+		{"dot", a.Dot(b), -4 + 10 - 18},
+		{"cross", a.Cross(b), NewCoordinate3D(-27, -6, 13)},
+		{"cross x y", NewCoordinate3D(1, 0, 0).Cross(NewCoordinate3D(0, 1, 0)), NewCoordinate3D(0, 0, 1)},
+		{"cross parallel", a.Cross(a.Scale(2)), NewCoordinate3D(0, 0, 0)},
+		{"manhattan", a.Manhattan(b), 5 + 3 + 9},
+		{"chebyshev", a.Chebyshev(b), 9},
+		{"min", a.Min(b), NewCoordinate3D(-4, 2, -6)},
+		{"max", a.Max(b), NewCoordinate3D(1, 5, 3)},
+		{"translate", a.Translate(1, 1, 1), NewCoordinate3D(2, 3, 4)},
+		{"float", a.Float(), NewCoordinate3D(1.0, 2.0, 3.0)},
+		{"round", f.Round(), NewCoordinate3D(1, -2, 2)},
+		{"float scale", f.Scale(2), NewCoordinate3D(1.0, -3.0, 4.0)},
+		{"float manhattan", f.Manhattan(NewCoordinate3D(0.0, 0.0, 0.0)), 4.0},
+		{"float chebyshev", f.Chebyshev(NewCoordinate3D(0.0, 0.0, 0.0)), 2.0},
+		{"float dot", f.Dot(a.Float()), 0.5 - 3 + 6},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
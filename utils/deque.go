@@ -0,0 +1,105 @@
+package utils
+
+// minDequeCap is the smallest the ring buffer gets, so small deques don't keep resizing
+const minDequeCap = 8
+
+// Deque is a double ended queue kept in a ring buffer, so space freed up at either end gets reused
+// instead of leaking like reslicing does
+type Deque[T any] struct {
+	data []T
+	head int
+	len  int
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+func (d *Deque[T]) Len() int {
+	return d.len
+}
+
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.data[(d.head+d.len)%len(d.data)] = v
+	d.len++
+}
+
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.data)) % len(d.data)
+	d.data[d.head] = v
+	d.len++
+}
+
+func (d *Deque[T]) PopFront() T {
+	if d.len == 0 {
+		panic("deque empty")
+	}
+
+	var zero T
+	v := d.data[d.head]
+	// clear it out so whatever it points to can be garbage collected
+	d.data[d.head] = zero
+	d.head = (d.head + 1) % len(d.data)
+	d.len--
+
+	d.shrink()
+	return v
+}
+
+func (d *Deque[T]) PopBack() T {
+	if d.len == 0 {
+		panic("deque empty")
+	}
+
+	var zero T
+	i := (d.head + d.len - 1) % len(d.data)
+	v := d.data[i]
+	d.data[i] = zero
+	d.len--
+
+	d.shrink()
+	return v
+}
+
+func (d *Deque[T]) PeekFront() T {
+	if d.len == 0 {
+		panic("deque empty")
+	}
+	return d.data[d.head]
+}
+
+func (d *Deque[T]) PeekBack() T {
+	if d.len == 0 {
+		panic("deque empty")
+	}
+	return d.data[(d.head+d.len-1)%len(d.data)]
+}
+
+// grow doubles the ring buffer when it's full
+func (d *Deque[T]) grow() {
+	if d.len < len(d.data) {
+		return
+	}
+	d.resize(max(minDequeCap, len(d.data)*2))
+}
+
+// shrink halves the ring buffer once it's only a quarter full, so a big spike doesn't hold on to
+// its memory forever
+func (d *Deque[T]) shrink() {
+	if len(d.data) > minDequeCap && d.len <= len(d.data)/4 {
+		d.resize(len(d.data) / 2)
+	}
+}
+
+func (d *Deque[T]) resize(capacity int) {
+	data := make([]T, capacity)
+	if d.len > 0 {
+		// the values might wrap around the end of the buffer
+		n := copy(data, d.data[d.head:min(d.head+d.len, len(d.data))])
+		copy(data[n:], d.data[:d.len-n])
+	}
+	d.data = data
+	d.head = 0
+}
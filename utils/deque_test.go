@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	// check against a plain slice doing the same thing
+	r := rand.New(rand.NewSource(19))
+	d := NewDeque[int]()
+	want := []int{}
+
+	for i := 0; i < 10_000; i++ {
+		// lean towards pushing for the first half and popping for the second, so it grows and shrinks
+		push := r.Intn(10) < 6
+		if i > 5_000 {
+			push = r.Intn(10) < 4
+		}
+
+		switch {
+		case push && r.Intn(2) == 0:
+			d.PushBack(i)
+			want = append(want, i)
+		case push:
+			d.PushFront(i)
+			want = append([]int{i}, want...)
+		case len(want) == 0:
+			continue
+		case r.Intn(2) == 0:
+			if got := d.PopBack(); got != want[len(want)-1] {
+				t.Fatalf("step %d: PopBack = %d, want %d", i, got, want[len(want)-1])
+			}
+			want = want[:len(want)-1]
+		default:
+			if got := d.PopFront(); got != want[0] {
+				t.Fatalf("step %d: PopFront = %d, want %d", i, got, want[0])
+			}
+			want = want[1:]
+		}
+
+		if d.Len() != len(want) {
+			t.Fatalf("step %d: Len = %d, want %d", i, d.Len(), len(want))
+		}
+		if len(want) > 0 && (d.PeekFront() != want[0] || d.PeekBack() != want[len(want)-1]) {
+			t.Fatalf("step %d: peeked %d and %d, want %d and %d", i, d.PeekFront(), d.PeekBack(), want[0], want[len(want)-1])
+		}
+	}
+}
+
+func TestDequeReusesMemory(t *testing.T) {
+	// a queue that never holds more than a few values at once shouldn't keep growing
+	q := NewQueue[int]()
+	for i := 0; i < 100_000; i++ {
+		q.Push(i)
+		q.Push(i)
+		q.Pop()
+		if q.Len() > 0 && i%3 == 0 {
+			q.Pop()
+		}
+	}
+	for q.Len() > 0 {
+		q.Pop()
+	}
+
+	if c := len(q.d.data); c > minDequeCap {
+		t.Errorf("expected the buffer to shrink back down once empty, but it holds %d", c)
+	}
+}
+
+func TestQueueAndStack(t *testing.T) {
+	q := NewQueue[int]()
+	s := NewStack[int]()
+	for i := 0; i < 20; i++ {
+		q.Push(i)
+		s.Push(i)
+	}
+
+	if q.Peek() != 0 || s.Peek() != 19 {
+		t.Errorf("peeked %d and %d, want 0 and 19", q.Peek(), s.Peek())
+	}
+
+	gotQ, gotS := []int{}, []int{}
+	for q.Len() > 0 {
+		gotQ = append(gotQ, q.Pop())
+		gotS = append(gotS, s.Pop())
+	}
+
+	wantQ := make([]int, 20)
+	for i := range wantQ {
+		wantQ[i] = i
+	}
+	wantS := slices.Clone(wantQ)
+	slices.Reverse(wantS)
+	if !slices.Equal(gotQ, wantQ) || !slices.Equal(gotS, wantS) {
+		t.Errorf("queue popped %v, stack popped %v", gotQ, gotS)
+	}
+}
+
+func BenchmarkQueue(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		q := NewQueue[int]()
+		for j := 0; j < 1000; j++ {
+			q.Push(j)
+			q.Push(j)
+			q.Pop()
+		}
+		for q.Len() > 0 {
+			q.Pop()
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownDirection = errors.New("unknown direction")
+)
+
+// Direction is a single step on a grid. Y goes down, so UP is a step to a smaller Y.
+type Direction Coordinate
+
+var (
+	UP    = Direction(NewCoordinate(0, -1))
+	DOWN  = Direction(NewCoordinate(0, 1))
+	LEFT  = Direction(NewCoordinate(-1, 0))
+	RIGHT = Direction(NewCoordinate(1, 0))
+
+	UP_LEFT    = Direction(NewCoordinate(-1, -1))
+	UP_RIGHT   = Direction(NewCoordinate(1, -1))
+	DOWN_LEFT  = Direction(NewCoordinate(-1, 1))
+	DOWN_RIGHT = Direction(NewCoordinate(1, 1))
+)
+
+// Cardinals are the 4 directions, going clockwise from UP
+func Cardinals() []Direction {
+	return []Direction{UP, RIGHT, DOWN, LEFT}
+}
+
+// Diagonals are the 4 diagonal directions, going clockwise from UP_RIGHT
+func Diagonals() []Direction {
+	return []Direction{UP_RIGHT, DOWN_RIGHT, DOWN_LEFT, UP_LEFT}
+}
+
+// All8 are the cardinal and diagonal directions, going clockwise from UP
+func All8() []Direction {
+	return []Direction{UP, UP_RIGHT, RIGHT, DOWN_RIGHT, DOWN, DOWN_LEFT, LEFT, UP_LEFT}
+}
+
+// ParseDirection reads a cardinal direction written as U/D/L/R, N/S/E/W, ^/v/</>, or a digit
+// where 0 is RIGHT and it goes clockwise from there. The names from String work too. It's case
+// sensitive, so a hex digit like "e" is never taken as a direction.
+func ParseDirection(s string) (Direction, error) {
+	switch s {
+	case "U", "N", "^", "3", "UP":
+		return UP, nil
+	case "D", "S", "v", "1", "DOWN":
+		return DOWN, nil
+	case "L", "W", "<", "2", "LEFT":
+		return LEFT, nil
+	case "R", "E", ">", "0", "RIGHT":
+		return RIGHT, nil
+	case "UP_LEFT":
+		return UP_LEFT, nil
+	case "UP_RIGHT":
+		return UP_RIGHT, nil
+	case "DOWN_LEFT":
+		return DOWN_LEFT, nil
+	case "DOWN_RIGHT":
+		return DOWN_RIGHT, nil
+	}
+	return Direction{}, fmt.Errorf("%w: %q", ErrUnknownDirection, s)
+}
+
+// TurnRight turns 90 degrees clockwise
+func (d Direction) TurnRight() Direction {
+	return Direction{X: -d.Y, Y: d.X}
+}
+
+// TurnLeft turns 90 degrees counterclockwise
+func (d Direction) TurnLeft() Direction {
+	return Direction{X: d.Y, Y: -d.X}
+}
+
+func (d Direction) Opposite() Direction {
+	return Direction{X: -d.X, Y: -d.Y}
+}
+
+func (d Direction) IsDiagonal() bool {
+	return d.X != 0 && d.Y != 0
+}
+
+// Arrow is the ^v<> character for a cardinal direction, or ? for anything else
+func (d Direction) Arrow() string {
+	switch d {
+	case UP:
+		return "^"
+	case DOWN:
+		return "v"
+	case LEFT:
+		return "<"
+	case RIGHT:
+		return ">"
+	}
+	return "?"
+}
+
+func (d Direction) String() string {
+	switch d {
+	case UP:
+		return "UP"
+	case DOWN:
+		return "DOWN"
+	case LEFT:
+		return "LEFT"
+	case RIGHT:
+		return "RIGHT"
+	case UP_LEFT:
+		return "UP_LEFT"
+	case UP_RIGHT:
+		return "UP_RIGHT"
+	case DOWN_LEFT:
+		return "DOWN_LEFT"
+	case DOWN_RIGHT:
+		return "DOWN_RIGHT"
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDirectionTurns(t *testing.T) {
+	tests := []struct {
+		dir      Direction
+		left     Direction
+		right    Direction
+		opposite Direction
+		isDiag   bool
+	}{
+		{UP, LEFT, RIGHT, DOWN, false},
+		{RIGHT, UP, DOWN, LEFT, false},
+		{DOWN, RIGHT, LEFT, UP, false},
+		{LEFT, DOWN, UP, RIGHT, false},
+		{UP_RIGHT, UP_LEFT, DOWN_RIGHT, DOWN_LEFT, true},
+		{DOWN_LEFT, DOWN_RIGHT, UP_LEFT, UP_RIGHT, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.dir.TurnLeft(); got != tt.left {
+			t.Errorf("%s.TurnLeft() = %s, want %s", tt.dir, got, tt.left)
+		}
+		if got := tt.dir.TurnRight(); got != tt.right {
+			t.Errorf("%s.TurnRight() = %s, want %s", tt.dir, got, tt.right)
+		}
+		if got := tt.dir.Opposite(); got != tt.opposite {
+			t.Errorf("%s.Opposite() = %s, want %s", tt.dir, got, tt.opposite)
+		}
+		if got := tt.dir.IsDiagonal(); got != tt.isDiag {
+			t.Errorf("%s.IsDiagonal() = %v, want %v", tt.dir, got, tt.isDiag)
+		}
+	}
+}
+
+func TestDirectionSets(t *testing.T) {
+	// each set goes clockwise, so turning right twice is the next one in All8
+	all := All8()
+	for i, d := range all {
+		if next := all[(i+2)%len(all)]; d.TurnRight() != next {
+			t.Errorf("%s.TurnRight() = %s, want %s", d, d.TurnRight(), next)
+		}
+	}
+
+	for i, d := range Cardinals() {
+		if d != all[i*2] {
+			t.Errorf("Cardinals()[%d] = %s, want %s", i, d, all[i*2])
+		}
+	}
+	for i, d := range Diagonals() {
+		if d != all[i*2+1] {
+			t.Errorf("Diagonals()[%d] = %s, want %s", i, d, all[i*2+1])
+		}
+	}
+
+	seen := map[Direction]bool{}
+	for _, d := range all {
+		if seen[d] {
+			t.Errorf("%s is in All8 twice", d)
+		}
+		seen[d] = true
+		if d.String() == "" {
+			t.Errorf("%v has no name", Coordinate(d))
+		}
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	tests := []struct {
+		want   Direction
+		inputs []string
+	}{
+		{UP, []string{"U", "N", "^", "3", "UP"}},
+		{DOWN, []string{"D", "S", "v", "1", "DOWN"}},
+		{LEFT, []string{"L", "W", "<", "2", "LEFT"}},
+		{RIGHT, []string{"R", "E", ">", "0", "RIGHT"}},
+	}
+
+	for _, tt := range tests {
+		for _, s := range tt.inputs {
+			got, err := ParseDirection(s)
+			if err != nil {
+				t.Errorf("ParseDirection(%q) failed: %v", s, err)
+			} else if got != tt.want {
+				t.Errorf("ParseDirection(%q) = %s, want %s", s, got, tt.want)
+			}
+		}
+	}
+
+	for _, d := range All8() {
+		if got, err := ParseDirection(d.String()); err != nil || got != d {
+			t.Errorf("ParseDirection(%q) = %s, %v, want %s", d.String(), got, err, d)
+		}
+	}
+
+	for _, s := range []string{"", "e", "4", "X", "UPLEFT"} {
+		if _, err := ParseDirection(s); !errors.Is(err, ErrUnknownDirection) {
+			t.Errorf("ParseDirection(%q) error = %v, want %v", s, err, ErrUnknownDirection)
+		}
+	}
+}
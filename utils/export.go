@@ -0,0 +1,294 @@
+package utils
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnknownGraphFormat = errors.New("unknown graph format")
+)
+
+type GraphFormat string
+
+const (
+	GraphFormatDOT     GraphFormat = "dot"
+	GraphFormatMermaid GraphFormat = "mermaid"
+	GraphFormatJSON    GraphFormat = "json"
+)
+
+var GraphFormats = []GraphFormat{GraphFormatDOT, GraphFormatMermaid, GraphFormatJSON}
+
+func ParseGraphFormat(s string) (GraphFormat, error) {
+	for _, f := range GraphFormats {
+		if string(f) == strings.ToLower(s) {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownGraphFormat, s)
+}
+
+// AdjacencyList is a graph where every edge has a weight of 1
+type AdjacencyList[K comparable] map[K][]K
+
+func (adj AdjacencyList[K]) Neighbors(node K) []Edge[K] {
+	return SliceMap(adj[node], func(n K) Edge[K] {
+		return Edge[K]{To: n, Weight: 1}
+	})
+}
+
+func (adj AdjacencyList[K]) Nodes() []K {
+	nodes := make([]K, 0, len(adj))
+	for node := range adj {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// ExportAttrs changes how a node or edge is drawn. Empty fields are left at their defaults.
+type ExportAttrs struct {
+	// Label replaces the node's name, or the edge's weight
+	Label string
+	// Color is a color name like "red", or a hex color like "#ff0000"
+	Color string
+}
+
+// GraphExporter writes a graph out in a format other tools can draw
+type GraphExporter[K comparable] struct {
+	Graph FiniteGraph[K]
+	// Directed draws edges as arrows. Otherwise an edge listed both ways is only drawn once.
+	Directed bool
+	// ShowWeights labels each edge with its weight
+	ShowWeights bool
+	NodeAttrs   func(node K) ExportAttrs
+	EdgeAttrs   func(from, to K, weight int) ExportAttrs
+}
+
+type exportNode[K comparable] struct {
+	node  K
+	name  string
+	attrs ExportAttrs
+}
+
+type exportEdge struct {
+	// from and to are indexes into the nodes
+	from, to int
+	weight   int
+	attrs    ExportAttrs
+}
+
+// collect gets every node and edge with their attributes, sorted by name so the output is always the same
+func (e GraphExporter[K]) collect() ([]exportNode[K], []exportEdge) {
+	idxs := map[K]int{}
+	nodes := []exportNode[K]{}
+	addNode := func(n K) {
+		if _, ok := idxs[n]; !ok {
+			idxs[n] = len(nodes)
+			nodes = append(nodes, exportNode[K]{node: n, name: fmt.Sprint(n)})
+		}
+	}
+	for _, n := range e.Graph.Nodes() {
+		addNode(n)
+		for _, edge := range e.Graph.Neighbors(n) {
+			addNode(edge.To)
+		}
+	}
+
+	slices.SortFunc(nodes, func(a, b exportNode[K]) int {
+		return cmp.Compare(a.name, b.name)
+	})
+	for i := range nodes {
+		idxs[nodes[i].node] = i
+
+		nodes[i].attrs.Label = nodes[i].name
+		if e.NodeAttrs != nil {
+			nodes[i].attrs = mergeAttrs(nodes[i].attrs, e.NodeAttrs(nodes[i].node))
+		}
+	}
+
+	edges := []exportEdge{}
+	seen := map[[2]int]bool{}
+	for i, n := range nodes {
+		neighbors := e.Graph.Neighbors(n.node)
+		slices.SortFunc(neighbors, func(a, b Edge[K]) int {
+			return cmp.Compare(idxs[a.To], idxs[b.To])
+		})
+
+		for _, edge := range neighbors {
+			to := idxs[edge.To]
+			if !e.Directed && seen[[2]int{to, i}] {
+				continue
+			}
+			seen[[2]int{i, to}] = true
+
+			attrs := ExportAttrs{}
+			if e.ShowWeights {
+				attrs.Label = strconv.Itoa(edge.Weight)
+			}
+			if e.EdgeAttrs != nil {
+				attrs = mergeAttrs(attrs, e.EdgeAttrs(n.node, edge.To, edge.Weight))
+			}
+			edges = append(edges, exportEdge{from: i, to: to, weight: edge.Weight, attrs: attrs})
+		}
+	}
+
+	return nodes, edges
+}
+
+func mergeAttrs(base, override ExportAttrs) ExportAttrs {
+	if override.Label != "" {
+		base.Label = override.Label
+	}
+	if override.Color != "" {
+		base.Color = override.Color
+	}
+	return base
+}
+
+func (e GraphExporter[K]) Export(format GraphFormat) (string, error) {
+	switch format {
+	case GraphFormatDOT:
+		return e.DOT(), nil
+	case GraphFormatMermaid:
+		return e.Mermaid(), nil
+	case GraphFormatJSON:
+		return e.JSON()
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownGraphFormat, format)
+}
+
+// DOT writes the graph in Graphviz's DOT language
+func (e GraphExporter[K]) DOT() string {
+	nodes, edges := e.collect()
+
+	graphType, edgeOp := "graph", "--"
+	if e.Directed {
+		graphType, edgeOp = "digraph", "->"
+	}
+
+	dotAttrs := func(attrs ExportAttrs) string {
+		s := []string{}
+		if attrs.Label != "" {
+			s = append(s, "label="+strconv.Quote(attrs.Label))
+		}
+		if attrs.Color != "" {
+			s = append(s, "color="+strconv.Quote(attrs.Color))
+		}
+		if len(s) == 0 {
+			return ""
+		}
+		return " [" + strings.Join(s, ", ") + "]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(graphType + " {\n")
+	for _, n := range nodes {
+		// the label is always there, so only write it out when it's different from the name
+		attrs := n.attrs
+		if attrs.Label == n.name {
+			attrs.Label = ""
+		}
+		fmt.Fprintf(&sb, "  %s%s\n", strconv.Quote(n.name), dotAttrs(attrs))
+	}
+	for _, edge := range edges {
+		fmt.Fprintf(&sb, "  %s %s %s%s\n", strconv.Quote(nodes[edge.from].name), edgeOp, strconv.Quote(nodes[edge.to].name), dotAttrs(edge.attrs))
+	}
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// Mermaid writes the graph as a Mermaid flowchart
+func (e GraphExporter[K]) Mermaid() string {
+	nodes, edges := e.collect()
+
+	arrow := "---"
+	if e.Directed {
+		arrow = "-->"
+	}
+
+	// mermaid doesn't allow much in labels, so quote them and swap out the quotes inside
+	label := func(s string) string {
+		return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+	}
+
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for i, n := range nodes {
+		// node names can be anything, so each gets an id that's safe to use
+		fmt.Fprintf(&sb, "  n%d[%s]\n", i, label(n.attrs.Label))
+	}
+	for _, edge := range edges {
+		if edge.attrs.Label != "" {
+			fmt.Fprintf(&sb, "  n%d %s|%s| n%d\n", edge.from, arrow, label(edge.attrs.Label), edge.to)
+		} else {
+			fmt.Fprintf(&sb, "  n%d %s n%d\n", edge.from, arrow, edge.to)
+		}
+	}
+	for i, n := range nodes {
+		if n.attrs.Color != "" {
+			fmt.Fprintf(&sb, "  style n%d stroke:%s,color:%s\n", i, n.attrs.Color, n.attrs.Color)
+		}
+	}
+	for i, edge := range edges {
+		if edge.attrs.Color != "" {
+			fmt.Fprintf(&sb, "  linkStyle %d stroke:%s\n", i, edge.attrs.Color)
+		}
+	}
+
+	return sb.String()
+}
+
+type jsonGraph struct {
+	Directed bool       `json:"directed"`
+	Nodes    []jsonNode `json:"nodes"`
+	Links    []jsonLink `json:"links"`
+}
+
+type jsonNode struct {
+	ID    string `json:"id"`
+	Label string `json:"label,omitempty"`
+	Color string `json:"color,omitempty"`
+}
+
+type jsonLink struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Weight int    `json:"weight"`
+	Label  string `json:"label,omitempty"`
+	Color  string `json:"color,omitempty"`
+}
+
+// JSON writes the graph in the node-link format that d3 and networkx use
+func (e GraphExporter[K]) JSON() (string, error) {
+	nodes, edges := e.collect()
+
+	g := jsonGraph{
+		Directed: e.Directed,
+		Nodes:    make([]jsonNode, len(nodes)),
+		Links:    make([]jsonLink, len(edges)),
+	}
+	for i, n := range nodes {
+		g.Nodes[i] = jsonNode{ID: n.name, Label: n.attrs.Label, Color: n.attrs.Color}
+	}
+	for i, edge := range edges {
+		g.Links[i] = jsonLink{
+			Source: nodes[edge.from].name,
+			Target: nodes[edge.to].name,
+			Weight: edge.weight,
+			Label:  edge.attrs.Label,
+			Color:  edge.attrs.Color,
+		}
+	}
+
+	b, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b) + "\n", nil
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestGraphExporter(t *testing.T) {
+	cMap := ConnectionMap[string]{
+		"a": {"b": 3, "c": 1},
+		"b": {"a": 3},
+		"c": {"a": 1},
+	}
+	exporter := GraphExporter[string]{
+		Graph:       cMap,
+		ShowWeights: true,
+		NodeAttrs: func(n string) ExportAttrs {
+			if n == "c" {
+				return ExportAttrs{Label: "see", Color: "blue"}
+			}
+			return ExportAttrs{}
+		},
+		EdgeAttrs: func(from, to string, weight int) ExportAttrs {
+			if weight == 3 {
+				return ExportAttrs{Color: "red"}
+			}
+			return ExportAttrs{}
+		},
+	}
+
+	wantDOT := `graph {
+  "a"
+  "b"
+  "c" [label="see", color="blue"]
+  "a" -- "b" [label="3", color="red"]
+  "a" -- "c" [label="1"]
+}
+`
+	if got := exporter.DOT(); got != wantDOT {
+		t.Errorf("got DOT:\n%s\nwant:\n%s", got, wantDOT)
+	}
+
+	wantMermaid := `flowchart LR
+  n0["a"]
+  n1["b"]
+  n2["see"]
+  n0 ---|"3"| n1
+  n0 ---|"1"| n2
+  style n2 stroke:blue,color:blue
+  linkStyle 0 stroke:red
+`
+	if got := exporter.Mermaid(); got != wantMermaid {
+		t.Errorf("got Mermaid:\n%s\nwant:\n%s", got, wantMermaid)
+	}
+
+	out, err := exporter.Export(GraphFormatJSON)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var g jsonGraph
+	if err := json.Unmarshal([]byte(out), &g); err != nil {
+		t.Fatalf("failed to read the JSON back: %s", err)
+	}
+	if g.Directed || len(g.Nodes) != 3 || len(g.Links) != 2 {
+		t.Errorf("got %+v", g)
+	}
+	if l := g.Links[0]; l.Source != "a" || l.Target != "b" || l.Weight != 3 || l.Color != "red" {
+		t.Errorf("got first link %+v", l)
+	}
+
+	if _, err := exporter.Export("png"); !errors.Is(err, ErrUnknownGraphFormat) {
+		t.Errorf("expected ErrUnknownGraphFormat, got %v", err)
+	}
+}
+
+func TestGraphExporterDirected(t *testing.T) {
+	// edges going both ways are both drawn when it's directed
+	adj := AdjacencyList[string]{
+		"x": {"y", "z"},
+		"y": {"x"},
+	}
+	exporter := GraphExporter[string]{Graph: adj, Directed: true}
+
+	want := `digraph {
+  "x"
+  "y"
+  "z"
+  "x" -> "y"
+  "x" -> "z"
+  "y" -> "x"
+}
+`
+	if got := exporter.DOT(); got != want {
+		t.Errorf("got DOT:\n%s\nwant:\n%s", got, want)
+	}
+
+	if f, err := ParseGraphFormat("Mermaid"); err != nil || f != GraphFormatMermaid {
+		t.Errorf("ParseGraphFormat = %q, %v", f, err)
+	}
+}
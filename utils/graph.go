@@ -1,123 +1,140 @@
 package utils
 
 import (
+	"cmp"
 	"math"
 	"slices"
 )
 
-type ConnectionMap[K comparable] map[K]map[K]int
+// Edge is a weighted connection to another node
+type Edge[K comparable] struct {
+	To     K
+	Weight int
+}
 
-func (cMap ConnectionMap[K]) Betweeness() map[K]float64 {
-	cb := make(map[K]float64)
+// Graph is anything that can list a node's outgoing edges. The nodes don't need to exist up front,
+// so searches over big state spaces can expand them as they go.
+type Graph[K comparable] interface {
+	Neighbors(node K) []Edge[K]
+}
 
-	cMap.brandes(func(n K, stack *Stack[K], p map[K][]K, delta, sigma map[K]float64) {
-		for stack.Len() > 0 {
-			w := stack.Pop()
-			for _, v := range p[w] {
-				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
-			}
-			if w != n {
-				if d := delta[w]; d != 0 {
-					cb[w] += d
-				}
-			}
-		}
-	})
+// FiniteGraph is a Graph that can also list all of its nodes
+type FiniteGraph[K comparable] interface {
+	Graph[K]
+	Nodes() []K
+}
+
+// GraphFunc makes a Graph out of a function that finds a node's edges
+type GraphFunc[K comparable] func(node K) []Edge[K]
 
-	return cb
+func (f GraphFunc[K]) Neighbors(node K) []Edge[K] {
+	return f(node)
 }
 
-func (cMap ConnectionMap[K]) EdgeBetweeness() map[K]map[K]float64 {
-	cb := make(map[K]map[K]float64)
+type ConnectionMap[K comparable] map[K]map[K]int
+
+func (cMap ConnectionMap[K]) Neighbors(node K) []Edge[K] {
+	edges := make([]Edge[K], 0, len(cMap[node]))
+	for neighbor, weight := range cMap[node] {
+		edges = append(edges, Edge[K]{To: neighbor, Weight: weight})
+	}
+	return edges
+}
 
-	cMap.brandes(func(n K, stack *Stack[K], p map[K][]K, delta, sigma map[K]float64) {
-		for stack.Len() != 0 {
-			w := stack.Pop()
-			for _, v := range p[w] {
-				c := sigma[v] / sigma[w] * (1 + delta[w])
-				if _, ok := cb[v]; !ok {
-					cb[v] = map[K]float64{}
-				}
-				cb[v][w] += c
-				delta[v] += c
+func (cMap ConnectionMap[K]) Nodes() []K {
+	nodes := make([]K, 0, len(cMap))
+	for node := range cMap {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// Dijkstra finds the cheapest cost from source to every node that can be reached from it,
+// along with the previous node on the cheapest path to each of them
+func Dijkstra[K comparable](g Graph[K], source K) (map[K]int, map[K]K) {
+	distances := map[K]int{source: 0}
+	prev := map[K]K{}
+	done := map[K]bool{}
+
+	pq := NewPriorityQueue[K, int]()
+	pq.Push(source, 0)
+
+	for pq.Len() > 0 {
+		curNode, curNodeDist := pq.Pop()
+		done[curNode] = true
+
+		for _, edge := range g.Neighbors(curNode) {
+			if done[edge.To] {
+				continue
+			}
+
+			alt := curNodeDist + edge.Weight
+			if dist, ok := distances[edge.To]; !ok || alt < dist {
+				distances[edge.To] = alt
+				pq.Update(edge.To, alt)
+				prev[edge.To] = curNode
 			}
 		}
-	})
+	}
 
-	return cb
+	return distances, prev
 }
 
-func (cMap ConnectionMap[K]) brandes(accumulate func(n K, stack *Stack[K], p map[K][]K, delta, sigma map[K]float64)) {
-	// based off of gonum's implementation: https://github.com/gonum/gonum/blob/v0.14.0/graph/network/betweenness.go
-
-	p := make(map[K][]K, len(cMap))
-	sigma := make(map[K]float64, len(cMap))
-	d := make(map[K]int, len(cMap))
-	delta := make(map[K]float64, len(cMap))
+// BFS finds the fewest edges from source to every node that can be reached from it, ignoring
+// their weights, along with the previous node on a shortest path to each of them
+func BFS[K comparable](g Graph[K], source K) (map[K]int, map[K]K) {
+	steps := map[K]int{source: 0}
+	prev := map[K]K{}
 
 	queue := NewQueue[K]()
+	queue.Push(source)
 
-	for n := range cMap {
-		stack := NewStack[K]()
+	for queue.Len() > 0 {
+		curNode := queue.Pop()
 
-		// reset everything
-		for w := range cMap {
-			p[w] = p[w][:0]
-			sigma[w] = 0
-			d[w] = -1
-		}
-		sigma[n] = 1
-		d[n] = 0
-
-		queue.Push(n)
-		for queue.Len() > 0 {
-			v := queue.Pop()
-
-			stack.Push(v)
-
-			for neighbor := range cMap[v] {
-				// neighbor found for first time
-				if d[neighbor] < 0 {
-					queue.Push(neighbor)
-					d[neighbor] = d[v] + 1
-				}
-				// shortest path to neighbor from v
-				if d[neighbor] == d[v]+1 {
-					sigma[neighbor] += sigma[v]
-					p[neighbor] = append(p[neighbor], v)
-				}
+		for _, edge := range g.Neighbors(curNode) {
+			if _, ok := steps[edge.To]; ok {
+				continue
 			}
-		}
 
-		for v := range cMap {
-			delta[v] = 0
+			steps[edge.To] = steps[curNode] + 1
+			prev[edge.To] = curNode
+			queue.Push(edge.To)
 		}
-
-		accumulate(n, stack, p, delta, sigma)
 	}
+
+	return steps, prev
 }
 
-func (cMap ConnectionMap[K]) Dijkstra(source K) (map[K]int, map[K]K) {
-	distances := make(map[K]int, len(cMap))
-	pq := NewPriorityQueue[K, int]()
+// ZeroOneBFS is Dijkstra for graphs where every edge weight is either 0 or 1. Nodes reached for free
+// go on the front of the queue and everything else goes on the back, so it never needs a priority queue.
+func ZeroOneBFS[K comparable](g Graph[K], source K) (map[K]int, map[K]K) {
+	distances := map[K]int{source: 0}
 	prev := map[K]K{}
+	done := map[K]bool{}
 
-	for vertex := range cMap {
-		distances[vertex] = math.MaxInt
-	}
-	distances[source] = 0
+	deque := NewDeque[K]()
+	deque.PushBack(source)
 
-	pq.Init(distances)
+	for deque.Len() > 0 {
+		curNode := deque.PopFront()
+		if done[curNode] {
+			continue
+		}
+		done[curNode] = true
 
-	for pq.Len() > 0 {
-		curNode, curNodeDist := pq.Pop()
+		for _, edge := range g.Neighbors(curNode) {
+			alt := distances[curNode] + edge.Weight
+			if dist, ok := distances[edge.To]; ok && alt >= dist {
+				continue
+			}
 
-		for neighbor, dist := range cMap[curNode] {
-			alt := curNodeDist + dist
-			if alt < distances[neighbor] {
-				distances[neighbor] = alt
-				pq.Update(neighbor, alt)
-				prev[neighbor] = curNode
+			distances[edge.To] = alt
+			prev[edge.To] = curNode
+			if edge.Weight == 0 {
+				deque.PushFront(edge.To)
+			} else {
+				deque.PushBack(edge.To)
 			}
 		}
 	}
@@ -125,38 +142,78 @@ func (cMap ConnectionMap[K]) Dijkstra(source K) (map[K]int, map[K]K) {
 	return distances, prev
 }
 
-func (cMap ConnectionMap[K]) DijkstraWithDest(source K, destination K) int {
-	distances := make(map[K]int, len(cMap))
-	pq := NewPriorityQueue[K, int]()
-
-	for vertex := range cMap {
-		distances[vertex] = math.MaxInt
-	}
-	distances[source] = 0
+// AStar finds the cheapest path from source to any node that isGoal, returning its cost and the path.
+// heuristic estimates the cost from a node to the nearest goal, and must never overestimate it for the
+// path to be the cheapest. If no goal can be reached the cost is math.MaxInt and the path is nil.
+func AStar[K comparable](g Graph[K], source K, isGoal func(K) bool, heuristic func(K) int) (int, []K) {
+	costs := map[K]int{source: 0}
+	prev := map[K]K{}
 
-	for vertex, distance := range distances {
-		pq.Push(vertex, distance)
-	}
+	pq := NewPriorityQueueFunc[K](compareAStarPriority)
+	pq.Push(source, aStarPriority{estimate: heuristic(source), heuristic: heuristic(source)})
 
 	for pq.Len() > 0 {
-		curNode, curNodeDist := pq.Pop()
-
-		if curNode == destination {
-			break
+		curNode, _ := pq.Pop()
+		if isGoal(curNode) {
+			return costs[curNode], reconstructPath(prev, source, curNode)
 		}
 
-		for neighbor, dist := range cMap[curNode] {
-			alt := curNodeDist + dist
-			if alt < distances[neighbor] {
-				distances[neighbor] = alt
-				pq.Update(neighbor, alt)
+		for _, edge := range g.Neighbors(curNode) {
+			// nodes are looked at again whenever a cheaper way to them is found
+			alt := costs[curNode] + edge.Weight
+			if cost, ok := costs[edge.To]; ok && alt >= cost {
+				continue
 			}
+
+			costs[edge.To] = alt
+			prev[edge.To] = curNode
+			h := heuristic(edge.To)
+			pq.Update(edge.To, aStarPriority{estimate: alt + h, heuristic: h})
+		}
+	}
+
+	return math.MaxInt, nil
+}
+
+type aStarPriority struct {
+	// estimate is the cost so far plus the heuristic
+	estimate  int
+	heuristic int
+}
+
+// compareAStarPriority looks at the lowest estimate first, and when estimates tie, the node closest to
+// the goal, since it's likely to get there soonest
+func compareAStarPriority(a, b aStarPriority) int {
+	if c := cmp.Compare(a.estimate, b.estimate); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.heuristic, b.heuristic)
+}
+
+// Dijkstra is like the Dijkstra func, except nodes that can't be reached are included with a distance of math.MaxInt
+func (cMap ConnectionMap[K]) Dijkstra(source K) (map[K]int, map[K]K) {
+	distances, prev := Dijkstra[K](cMap, source)
+
+	for vertex := range cMap {
+		if _, ok := distances[vertex]; !ok {
+			distances[vertex] = math.MaxInt
 		}
 	}
 
-	return distances[destination]
+	return distances, prev
 }
 
+func (cMap ConnectionMap[K]) DijkstraWithDest(source K, destination K) int {
+	cost, _ := cMap.AStar(source, func(k K) bool { return k == destination }, func(K) int { return 0 })
+	return cost
+}
+
+func (cMap ConnectionMap[K]) AStar(source K, isGoal func(K) bool, heuristic func(K) int) (int, []K) {
+	return AStar[K](cMap, source, isGoal, heuristic)
+}
+
+// LongestDijkstraWithDest is a quick way to find a long path, but it isn't guaranteed to find the longest one.
+// LongestSimplePath always does, but only works on small graphs.
 func (cMap ConnectionMap[K]) LongestDijkstraWithDest(source K, destination K) (int, []K) {
 	distances := make(map[K]int, len(cMap))
 	pq := NewPriorityQueue[K, int]()
@@ -212,7 +269,12 @@ func reconstructPath[K comparable](prev map[K]K, source, dest K) []K {
 
 	curNode := dest
 	for curNode != source {
-		curNode = prev[curNode]
+		next, ok := prev[curNode]
+		if !ok {
+			// source was never reached
+			return nil
+		}
+		curNode = next
 		path = append(path, curNode)
 	}
 
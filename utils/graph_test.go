@@ -0,0 +1,230 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// gridConnectionMap makes a size x size grid where each cell connects to its 4 neighbors
+func gridConnectionMap(size int) ConnectionMap[Coordinate] {
+	cMap := ConnectionMap[Coordinate]{}
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			coor := NewCoordinate(x, y)
+			cMap[coor] = map[Coordinate]int{}
+
+			for _, dir := range Cardinals() {
+				neighbor := coor.MoveDir(dir)
+				if neighbor.X < 0 || neighbor.Y < 0 || neighbor.X >= size || neighbor.Y >= size {
+					continue
+				}
+				// vary the weights so there's a single shortest path most of the time
+				cMap[coor][neighbor] = 1 + (neighbor.X*7+neighbor.Y*13)%9
+			}
+		}
+	}
+
+	return cMap
+}
+
+// gridGraph is the same graph as gridConnectionMap, but built lazily
+func gridGraph(size int) GraphFunc[Coordinate] {
+	return func(coor Coordinate) []Edge[Coordinate] {
+		edges := []Edge[Coordinate]{}
+		for _, dir := range Cardinals() {
+			neighbor := coor.MoveDir(dir)
+			if neighbor.X < 0 || neighbor.Y < 0 || neighbor.X >= size || neighbor.Y >= size {
+				continue
+			}
+			edges = append(edges, Edge[Coordinate]{To: neighbor, Weight: 1 + (neighbor.X*7+neighbor.Y*13)%9})
+		}
+		return edges
+	}
+}
+
+func TestDijkstraOnGraphFunc(t *testing.T) {
+	source := NewCoordinate(3, 4)
+	want, _ := gridConnectionMap(15).Dijkstra(source)
+	got, prev := Dijkstra[Coordinate](gridGraph(15), source)
+
+	if len(got) != len(want) {
+		t.Fatalf("reached %d nodes, want %d", len(got), len(want))
+	}
+	for node, dist := range want {
+		if got[node] != dist {
+			t.Errorf("distance to %s = %d, want %d", node, got[node], dist)
+		}
+	}
+
+	path := reconstructPath(prev, source, NewCoordinate(14, 14))
+	if path[0] != source || path[len(path)-1] != NewCoordinate(14, 14) {
+		t.Errorf("path %v doesn't go from source to dest", path)
+	}
+}
+
+func TestDijkstraUnreachable(t *testing.T) {
+	cMap := ConnectionMap[string]{
+		"a": {"b": 2},
+		"b": {"a": 2},
+		"c": {"a": 1},
+	}
+
+	dists, _ := cMap.Dijkstra("a")
+	if dists["b"] != 2 || dists["c"] != math.MaxInt {
+		t.Errorf("got distances %v", dists)
+	}
+
+	dists, _ = Dijkstra[string](cMap, "a")
+	if _, ok := dists["c"]; ok {
+		t.Errorf("c can't be reached, but got distances %v", dists)
+	}
+}
+
+func TestBFS(t *testing.T) {
+	steps, prev := BFS[Coordinate](gridGraph(10), NewCoordinate(0, 0))
+
+	if len(steps) != 100 {
+		t.Errorf("reached %d nodes, want 100", len(steps))
+	}
+	for node, s := range steps {
+		if want := node.X + node.Y; s != want {
+			t.Errorf("steps to %s = %d, want %d", node, s, want)
+		}
+	}
+
+	path := reconstructPath(prev, NewCoordinate(0, 0), NewCoordinate(9, 9))
+	if len(path) != 19 {
+		t.Errorf("got path of length %d, want 19", len(path))
+	}
+}
+
+func TestAStar(t *testing.T) {
+	cMap := gridConnectionMap(20)
+	source := NewCoordinate(0, 0)
+	dists, _ := cMap.Dijkstra(source)
+
+	for _, dest := range []Coordinate{{19, 19}, {0, 19}, {7, 3}, {0, 0}} {
+		isGoal := func(c Coordinate) bool { return c == dest }
+
+		heuristics := map[string]func(Coordinate) int{
+			"none":      func(Coordinate) int { return 0 },
+			"manhattan": func(c Coordinate) int { return c.StepsToCoordinate(dest) },
+		}
+		for name, heuristic := range heuristics {
+			cost, path := cMap.AStar(source, isGoal, heuristic)
+			if cost != dists[dest] {
+				t.Errorf("%s heuristic to %s: got cost %d, want %d", name, dest, cost, dists[dest])
+			}
+			if path[0] != source || path[len(path)-1] != dest {
+				t.Errorf("%s heuristic to %s: path %v doesn't go from source to dest", name, dest, path)
+			}
+
+			pathCost := 0
+			for i := 1; i < len(path); i++ {
+				pathCost += cMap[path[i-1]][path[i]]
+			}
+			if pathCost != cost {
+				t.Errorf("%s heuristic to %s: path costs %d, want %d", name, dest, pathCost, cost)
+			}
+		}
+	}
+
+	cost, path := cMap.AStar(source, func(c Coordinate) bool { return c.X > 100 }, func(Coordinate) int { return 0 })
+	if cost != math.MaxInt || path != nil {
+		t.Errorf("expected no path to an unreachable goal, got %d, %v", cost, path)
+	}
+}
+
+func BenchmarkDijkstra(b *testing.B) {
+	for _, size := range []int{10, 50, 100} {
+		cMap := gridConnectionMap(size)
+
+		b.Run(fmt.Sprintf("grid%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				cMap.Dijkstra(NewCoordinate(0, 0))
+			}
+		})
+	}
+}
+
+func BenchmarkDijkstraWithDest(b *testing.B) {
+	for _, size := range []int{10, 50, 100} {
+		cMap := gridConnectionMap(size)
+		dest := NewCoordinate(size-1, size-1)
+
+		b.Run(fmt.Sprintf("grid%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				cMap.DijkstraWithDest(NewCoordinate(0, 0), dest)
+			}
+		})
+	}
+}
+
+func BenchmarkAStar(b *testing.B) {
+	for _, size := range []int{10, 50, 100} {
+		cMap := gridConnectionMap(size)
+		dest := NewCoordinate(size-1, size-1)
+		isGoal := func(c Coordinate) bool { return c == dest }
+		heuristic := func(c Coordinate) int { return c.StepsToCoordinate(dest) }
+
+		b.Run(fmt.Sprintf("grid%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				cMap.AStar(NewCoordinate(0, 0), isGoal, heuristic)
+			}
+		})
+	}
+}
+
+func BenchmarkAStarGraphFunc(b *testing.B) {
+	for _, size := range []int{10, 50, 100} {
+		g := gridGraph(size)
+		dest := NewCoordinate(size-1, size-1)
+		isGoal := func(c Coordinate) bool { return c == dest }
+		heuristic := func(c Coordinate) int { return c.StepsToCoordinate(dest) }
+
+		b.Run(fmt.Sprintf("grid%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				AStar[Coordinate](g, NewCoordinate(0, 0), isGoal, heuristic)
+			}
+		})
+	}
+}
+
+func TestZeroOneBFS(t *testing.T) {
+	// moving right is free, everything else costs 1
+	size := 10
+	g := GraphFunc[Coordinate](func(coor Coordinate) []Edge[Coordinate] {
+		edges := []Edge[Coordinate]{}
+		for _, dir := range Cardinals() {
+			neighbor := coor.MoveDir(dir)
+			if neighbor.X < 0 || neighbor.Y < 0 || neighbor.X >= size || neighbor.Y >= size {
+				continue
+			}
+			weight := 1
+			if dir == RIGHT {
+				weight = 0
+			}
+			edges = append(edges, Edge[Coordinate]{To: neighbor, Weight: weight})
+		}
+		return edges
+	})
+
+	source := NewCoordinate(3, 3)
+	want, _ := Dijkstra[Coordinate](g, source)
+	got, _ := ZeroOneBFS[Coordinate](g, source)
+
+	if len(got) != len(want) {
+		t.Fatalf("reached %d nodes, want %d", len(got), len(want))
+	}
+	for node, dist := range want {
+		if got[node] != dist {
+			t.Errorf("distance to %s = %d, want %d", node, got[node], dist)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Grid is a 2D grid of values, indexed by [y][x]
+type Grid[T any] [][]T
+
+var (
+	neighbors4 = []Coordinate{
+		NewCoordinate(0, -1),
+		NewCoordinate(0, 1),
+		NewCoordinate(-1, 0),
+		NewCoordinate(1, 0),
+	}
+	neighbors8 = []Coordinate{
+		NewCoordinate(0, -1),
+		NewCoordinate(0, 1),
+		NewCoordinate(-1, 0),
+		NewCoordinate(1, 0),
+		NewCoordinate(-1, -1),
+		NewCoordinate(1, -1),
+		NewCoordinate(-1, 1),
+		NewCoordinate(1, 1),
+	}
+)
+
+func NewGrid[T any](width, height int, fill T) Grid[T] {
+	g := make(Grid[T], height)
+	for y := range g {
+		g[y] = make([]T, width)
+		for x := range g[y] {
+			g[y][x] = fill
+		}
+	}
+	return g
+}
+
+// ParseGrid reads a grid with one row per line, using f to turn each rune into a value.
+// Every row must be the same length.
+func ParseGrid[T any](r io.Reader, f func(r rune) (T, error)) (Grid[T], error) {
+	g := Grid[T]{}
+
+	err := ForEachLine(r, func(line string) error {
+		row := make([]T, 0, len(line))
+		for _, c := range line {
+			v, err := f(c)
+			if err != nil {
+				return err
+			}
+			row = append(row, v)
+		}
+
+		if len(g) > 0 && len(row) != len(g[0]) {
+			return fmt.Errorf("row has length %d, expected %d", len(row), len(g[0]))
+		}
+
+		g = append(g, row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// ParseCharGrid reads a grid of Chars with one row per line
+func ParseCharGrid(r io.Reader) (Grid[Char], error) {
+	return ParseGrid(r, func(r rune) (Char, error) {
+		return Char(r), nil
+	})
+}
+
+func (g Grid[T]) Width() int {
+	if len(g) == 0 {
+		return 0
+	}
+	return len(g[0])
+}
+
+func (g Grid[T]) Height() int {
+	return len(g)
+}
+
+func (g Grid[T]) InBounds(c Coordinate) bool {
+	return c.Y >= 0 && c.Y < len(g) && c.X >= 0 && c.X < len(g[c.Y])
+}
+
+func (g Grid[T]) Get(c Coordinate) T {
+	return g[c.Y][c.X]
+}
+
+// Ptr returns a pointer to the value at c, so that structs in the grid can be updated in place
+func (g Grid[T]) Ptr(c Coordinate) *T {
+	return &g[c.Y][c.X]
+}
+
+func (g Grid[T]) Set(c Coordinate, v T) {
+	g[c.Y][c.X] = v
+}
+
+// Neighbors4 returns the coordinates above, below, left and right of c that are in the grid
+func (g Grid[T]) Neighbors4(c Coordinate) []Coordinate {
+	return g.neighbors(c, neighbors4)
+}
+
+// Neighbors8 returns the coordinates around c, including diagonals, that are in the grid
+func (g Grid[T]) Neighbors8(c Coordinate) []Coordinate {
+	return g.neighbors(c, neighbors8)
+}
+
+func (g Grid[T]) neighbors(c Coordinate, offsets []Coordinate) []Coordinate {
+	neighbors := make([]Coordinate, 0, len(offsets))
+	for _, offset := range offsets {
+		n := c.Add(offset)
+		if g.InBounds(n) {
+			neighbors = append(neighbors, n)
+		}
+	}
+	return neighbors
+}
+
+// Row returns a copy of row y
+func (g Grid[T]) Row(y int) []T {
+	row := make([]T, len(g[y]))
+	copy(row, g[y])
+	return row
+}
+
+// Col returns a copy of column x
+func (g Grid[T]) Col(x int) []T {
+	col := make([]T, len(g))
+	for y := range g {
+		col[y] = g[y][x]
+	}
+	return col
+}
+
+// ForEach calls f on every coordinate in the grid, row by row
+func (g Grid[T]) ForEach(f func(c Coordinate, v T)) {
+	for y, row := range g {
+		for x, v := range row {
+			f(NewCoordinate(x, y), v)
+		}
+	}
+}
+
+// Find returns the first coordinate, row by row, whose value matches f
+func (g Grid[T]) Find(f func(v T) bool) (Coordinate, bool) {
+	for y, row := range g {
+		for x, v := range row {
+			if f(v) {
+				return NewCoordinate(x, y), true
+			}
+		}
+	}
+	return Coordinate{}, false
+}
+
+// FindAll returns every coordinate, row by row, whose value matches f
+func (g Grid[T]) FindAll(f func(v T) bool) []Coordinate {
+	coors := []Coordinate{}
+	g.ForEach(func(c Coordinate, v T) {
+		if f(v) {
+			coors = append(coors, c)
+		}
+	})
+	return coors
+}
+
+func (g Grid[T]) Clone() Grid[T] {
+	newGrid := make(Grid[T], len(g))
+	for y := range g {
+		newGrid[y] = g.Row(y)
+	}
+	return newGrid
+}
+
+// Transpose returns a new grid with the rows and columns swapped
+func (g Grid[T]) Transpose() Grid[T] {
+	newGrid := make(Grid[T], g.Width())
+	for x := range newGrid {
+		newGrid[x] = g.Col(x)
+	}
+	return newGrid
+}
+
+// RotateClockwise returns a new grid turned 90 degrees clockwise
+func (g Grid[T]) RotateClockwise() Grid[T] {
+	return g.Transpose().FlipHorizontal()
+}
+
+// RotateCounterClockwise returns a new grid turned 90 degrees counter clockwise
+func (g Grid[T]) RotateCounterClockwise() Grid[T] {
+	return g.Transpose().FlipVertical()
+}
+
+// FlipHorizontal returns a new grid mirrored left to right
+func (g Grid[T]) FlipHorizontal() Grid[T] {
+	newGrid := g.Clone()
+	for _, row := range newGrid {
+		for i, j := 0, len(row)-1; i < j; i, j = i+1, j-1 {
+			row[i], row[j] = row[j], row[i]
+		}
+	}
+	return newGrid
+}
+
+// FlipVertical returns a new grid mirrored top to bottom
+func (g Grid[T]) FlipVertical() Grid[T] {
+	newGrid := make(Grid[T], len(g))
+	for y := range g {
+		newGrid[len(g)-1-y] = g.Row(y)
+	}
+	return newGrid
+}
+
+// Render draws the grid one row per line, using f to draw each value
+func (g Grid[T]) Render(f func(c Coordinate, v T) string) string {
+	var sb strings.Builder
+	for y, row := range g {
+		if y > 0 {
+			sb.WriteByte('\n')
+		}
+		for x, v := range row {
+			sb.WriteString(f(NewCoordinate(x, y), v))
+		}
+	}
+	return sb.String()
+}
+
+func (g Grid[T]) String() string {
+	return g.Render(func(_ Coordinate, v T) string {
+		return fmt.Sprint(v)
+	})
+}
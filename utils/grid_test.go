@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testGrid = "abc\ndef"
+
+func parseTestGrid(t *testing.T) Grid[Char] {
+	t.Helper()
+	g, err := ParseCharGrid(strings.NewReader(testGrid))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return g
+}
+
+func TestParseGrid(t *testing.T) {
+	g := parseTestGrid(t)
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Fatalf("got %dx%d grid, want 3x2", g.Width(), g.Height())
+	}
+	if g.String() != testGrid {
+		t.Errorf("got grid %q, want %q", g.String(), testGrid)
+	}
+
+	if _, err := ParseCharGrid(strings.NewReader("abc\nde")); err == nil {
+		t.Error("expected error for a ragged grid")
+	}
+
+	errBad := errors.New("bad digit")
+	_, err := ParseGrid(strings.NewReader("12\n3x"), func(r rune) (int, error) {
+		if r < '0' || r > '9' {
+			return 0, errBad
+		}
+		return int(r - '0'), nil
+	})
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 2 || !errors.Is(err, errBad) {
+		t.Errorf("expected a ParseError on line 2, got %v", err)
+	}
+}
+
+func TestGridGetSet(t *testing.T) {
+	g := parseTestGrid(t)
+
+	c := NewCoordinate(2, 1)
+	if g.Get(c) != 'f' {
+		t.Errorf("got %s at %s, want f", g.Get(c), c)
+	}
+
+	g.Set(c, 'z')
+	*g.Ptr(NewCoordinate(0, 0)) = 'y'
+	if g.String() != "ybc\ndez" {
+		t.Errorf("got grid %q after setting", g.String())
+	}
+
+	for _, c := range []Coordinate{{-1, 0}, {0, -1}, {3, 0}, {0, 2}} {
+		if g.InBounds(c) {
+			t.Errorf("%s should be out of bounds", c)
+		}
+	}
+}
+
+func TestGridNeighbors(t *testing.T) {
+	g := NewGrid(3, 3, 0)
+
+	tests := []struct {
+		c     Coordinate
+		want4 int
+		want8 int
+	}{
+		{NewCoordinate(1, 1), 4, 8},
+		{NewCoordinate(0, 0), 2, 3},
+		{NewCoordinate(2, 1), 3, 5},
+	}
+
+	for _, tt := range tests {
+		if n := g.Neighbors4(tt.c); len(n) != tt.want4 {
+			t.Errorf("%s has 4-neighbors %v, want %d of them", tt.c, n, tt.want4)
+		}
+		if n := g.Neighbors8(tt.c); len(n) != tt.want8 {
+			t.Errorf("%s has 8-neighbors %v, want %d of them", tt.c, n, tt.want8)
+		}
+	}
+}
+
+func TestGridTransforms(t *testing.T) {
+	g := parseTestGrid(t)
+
+	tests := []struct {
+		name string
+		got  Grid[Char]
+		want string
+	}{
+		{"transpose", g.Transpose(), "ad\nbe\ncf"},
+		{"clockwise", g.RotateClockwise(), "da\neb\nfc"},
+		{"counter clockwise", g.RotateCounterClockwise(), "cf\nbe\nad"},
+		{"flip horizontal", g.FlipHorizontal(), "cba\nfed"},
+		{"flip vertical", g.FlipVertical(), "def\nabc"},
+	}
+
+	for _, tt := range tests {
+		if tt.got.String() != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got.String(), tt.want)
+		}
+	}
+
+	if g.String() != testGrid {
+		t.Errorf("transforms changed the original grid to %q", g.String())
+	}
+}
+
+func TestGridRowsAndFind(t *testing.T) {
+	g := parseTestGrid(t)
+
+	if row := g.Row(1); !slices.Equal(row, []Char("def")) {
+		t.Errorf("got row %v", row)
+	}
+	if col := g.Col(1); !slices.Equal(col, []Char("be")) {
+		t.Errorf("got col %v", col)
+	}
+
+	c, ok := g.Find(func(v Char) bool { return v == 'e' })
+	if !ok || c != NewCoordinate(1, 1) {
+		t.Errorf("found e at %s, %t", c, ok)
+	}
+	if _, ok := g.Find(func(v Char) bool { return v == 'z' }); ok {
+		t.Error("found z, which isn't in the grid")
+	}
+
+	vowels := g.FindAll(func(v Char) bool { return strings.ContainsRune("aeiou", rune(v)) })
+	if !slices.Equal(vowels, []Coordinate{{0, 0}, {1, 1}}) {
+		t.Errorf("found vowels at %v", vowels)
+	}
+
+	clone := g.Clone()
+	clone.Set(NewCoordinate(0, 0), 'z')
+	if g.Get(NewCoordinate(0, 0)) != 'a' {
+		t.Error("setting a value in a clone changed the original")
+	}
+}
@@ -0,0 +1,304 @@
+package utils
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Interval is every int from Start up to, but not including, End
+type Interval struct {
+	Start int
+	End   int
+}
+
+func NewInterval(start, end int) Interval {
+	return Interval{Start: start, End: end}
+}
+
+// NewIntervalLen makes the interval of length ints starting at start
+func NewIntervalLen(start, length int) Interval {
+	return Interval{Start: start, End: start + length}
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("[%d, %d)", i.Start, i.End)
+}
+
+// Len is how many ints are in the interval
+func (i Interval) Len() int {
+	return max(0, i.End-i.Start)
+}
+
+func (i Interval) Empty() bool {
+	return i.End <= i.Start
+}
+
+func (i Interval) Contains(n int) bool {
+	return n >= i.Start && n < i.End
+}
+
+func (i Interval) Overlaps(other Interval) bool {
+	return !i.Intersect(other).Empty()
+}
+
+// Intersect is the ints in both intervals, which is empty if they don't overlap
+func (i Interval) Intersect(other Interval) Interval {
+	return Interval{Start: max(i.Start, other.Start), End: min(i.End, other.End)}
+}
+
+// Subtract is the ints in i that aren't in other, which can be in up to two pieces
+func (i Interval) Subtract(other Interval) []Interval {
+	if !i.Overlaps(other) {
+		if i.Empty() {
+			return []Interval{}
+		}
+		return []Interval{i}
+	}
+
+	pieces := []Interval{}
+	if before := NewInterval(i.Start, other.Start); !before.Empty() {
+		pieces = append(pieces, before)
+	}
+	if after := NewInterval(other.End, i.End); !after.Empty() {
+		pieces = append(pieces, after)
+	}
+	return pieces
+}
+
+// SplitAt splits the interval into the ints below n and the ints from n up, either of which can be empty
+func (i Interval) SplitAt(n int) (Interval, Interval) {
+	n = min(max(n, i.Start), i.End)
+	return Interval{Start: i.Start, End: n}, Interval{Start: n, End: i.End}
+}
+
+// Shift moves the interval up by d
+func (i Interval) Shift(d int) Interval {
+	return Interval{Start: i.Start + d, End: i.End + d}
+}
+
+// IntervalSet is a set of ints made up of intervals. The intervals are kept sorted, and any that
+// overlap or touch are merged together, so there's only ever one way to hold the same set.
+type IntervalSet struct {
+	intervals []Interval
+}
+
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	sorted := SliceFilter(intervals, func(i Interval) bool { return !i.Empty() })
+	slices.SortFunc(sorted, func(a, b Interval) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	merged := []Interval{}
+	for _, i := range sorted {
+		if last := len(merged) - 1; last >= 0 && i.Start <= merged[last].End {
+			merged[last].End = max(merged[last].End, i.End)
+			continue
+		}
+		merged = append(merged, i)
+	}
+
+	return IntervalSet{intervals: merged}
+}
+
+// Intervals returns a copy of the intervals in the set, in order
+func (s IntervalSet) Intervals() []Interval {
+	return slices.Clone(s.intervals)
+}
+
+func (s IntervalSet) String() string {
+	return "{" + strings.Join(SliceMap(s.intervals, Interval.String), ", ") + "}"
+}
+
+// Len is how many ints are in the set
+func (s IntervalSet) Len() int {
+	total := 0
+	for _, i := range s.intervals {
+		total += i.Len()
+	}
+	return total
+}
+
+func (s IntervalSet) Empty() bool {
+	return len(s.intervals) == 0
+}
+
+func (s IntervalSet) Contains(n int) bool {
+	idx, found := slices.BinarySearchFunc(s.intervals, n, func(i Interval, n int) int {
+		return cmp.Compare(i.Start, n)
+	})
+	if found {
+		return true
+	}
+	// n is past the start of the interval before idx, if there is one
+	return idx > 0 && s.intervals[idx-1].Contains(n)
+}
+
+// Min is the smallest int in the set, and false if the set is empty
+func (s IntervalSet) Min() (int, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.intervals[0].Start, true
+}
+
+// Max is the biggest int in the set, and false if the set is empty
+func (s IntervalSet) Max() (int, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.intervals[len(s.intervals)-1].End - 1, true
+}
+
+func (s IntervalSet) Add(intervals ...Interval) IntervalSet {
+	return NewIntervalSet(append(slices.Clone(s.intervals), intervals...)...)
+}
+
+func (s IntervalSet) Union(other IntervalSet) IntervalSet {
+	return s.Add(other.intervals...)
+}
+
+func (s IntervalSet) Intersect(other IntervalSet) IntervalSet {
+	result := []Interval{}
+
+	// walk both in order, always moving past whichever interval ends first
+	i, j := 0, 0
+	for i < len(s.intervals) && j < len(other.intervals) {
+		if overlap := s.intervals[i].Intersect(other.intervals[j]); !overlap.Empty() {
+			result = append(result, overlap)
+		}
+		if s.intervals[i].End < other.intervals[j].End {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return IntervalSet{intervals: result}
+}
+
+func (s IntervalSet) Subtract(other IntervalSet) IntervalSet {
+	result := []Interval{}
+
+	for _, i := range s.intervals {
+		pieces := []Interval{i}
+		for _, o := range other.intervals {
+			if o.Start >= i.End {
+				break
+			}
+			next := []Interval{}
+			for _, p := range pieces {
+				next = append(next, p.Subtract(o)...)
+			}
+			pieces = next
+		}
+		result = append(result, pieces...)
+	}
+
+	return IntervalSet{intervals: result}
+}
+
+// SplitAt splits the set into the ints below n and the ints from n up
+func (s IntervalSet) SplitAt(n int) (IntervalSet, IntervalSet) {
+	below, above := []Interval{}, []Interval{}
+	for _, i := range s.intervals {
+		b, a := i.SplitAt(n)
+		if !b.Empty() {
+			below = append(below, b)
+		}
+		if !a.Empty() {
+			above = append(above, a)
+		}
+	}
+	return IntervalSet{intervals: below}, IntervalSet{intervals: above}
+}
+
+// Shift moves every int in the set up by d
+func (s IntervalSet) Shift(d int) IntervalSet {
+	return IntervalSet{intervals: SliceMap(s.intervals, func(i Interval) Interval { return i.Shift(d) })}
+}
+
+// Box is an N-dimensional box, with an interval for each dimension
+type Box []Interval
+
+func (b Box) String() string {
+	return strings.Join(SliceMap(b, Interval.String), " x ")
+}
+
+// Volume is how many points are in the box
+func (b Box) Volume() int {
+	if len(b) == 0 {
+		return 0
+	}
+
+	volume := 1
+	for _, i := range b {
+		volume *= i.Len()
+	}
+	return volume
+}
+
+func (b Box) Empty() bool {
+	return slices.ContainsFunc(b, Interval.Empty)
+}
+
+func (b Box) Contains(point ...int) bool {
+	if len(point) != len(b) {
+		return false
+	}
+	for d, i := range b {
+		if !i.Contains(point[d]) {
+			return false
+		}
+	}
+	return true
+}
+
+// With is a copy of the box with dimension d swapped out for i
+func (b Box) With(d int, i Interval) Box {
+	newB := slices.Clone(b)
+	newB[d] = i
+	return newB
+}
+
+// Intersect is the points in both boxes, which is empty if they don't overlap
+func (b Box) Intersect(other Box) Box {
+	newB := make(Box, len(b))
+	for d := range b {
+		newB[d] = b[d].Intersect(other[d])
+	}
+	return newB
+}
+
+// SplitAt splits the box along dimension d into the points below n and the points from n up
+func (b Box) SplitAt(d, n int) (Box, Box) {
+	below, above := b[d].SplitAt(n)
+	return b.With(d, below), b.With(d, above)
+}
+
+// Subtract is the points in b that aren't in other, as boxes that don't overlap each other
+func (b Box) Subtract(other Box) []Box {
+	overlap := b.Intersect(other)
+	if overlap.Empty() {
+		if b.Empty() {
+			return []Box{}
+		}
+		return []Box{b}
+	}
+
+	// peel off the slabs on either side of the overlap one dimension at a time
+	pieces := []Box{}
+	rest := b
+	for d := range b {
+		below, middle := rest.SplitAt(d, overlap[d].Start)
+		middle, above := middle.SplitAt(d, overlap[d].End)
+		for _, p := range []Box{below, above} {
+			if !p.Empty() {
+				pieces = append(pieces, p)
+			}
+		}
+		rest = middle
+	}
+	return pieces
+}
@@ -0,0 +1,159 @@
+package utils
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestInterval(t *testing.T) {
+	i := NewInterval(2, 8)
+
+	if i.Len() != 6 || !i.Contains(2) || i.Contains(8) {
+		t.Errorf("%s has the wrong length or bounds", i)
+	}
+	if got := i.Intersect(NewInterval(5, 20)); got != NewInterval(5, 8) {
+		t.Errorf("Intersect = %s", got)
+	}
+	if !i.Intersect(NewInterval(8, 10)).Empty() || i.Overlaps(NewInterval(8, 10)) {
+		t.Error("touching intervals shouldn't overlap")
+	}
+
+	tests := []struct {
+		other Interval
+		want  []Interval
+	}{
+		{NewInterval(4, 6), []Interval{{2, 4}, {6, 8}}},
+		{NewInterval(0, 5), []Interval{{5, 8}}},
+		{NewInterval(5, 10), []Interval{{2, 5}}},
+		{NewInterval(0, 10), []Interval{}},
+		{NewInterval(10, 12), []Interval{{2, 8}}},
+	}
+	for _, tt := range tests {
+		if got := i.Subtract(tt.other); !slices.Equal(got, tt.want) {
+			t.Errorf("%s - %s = %v, want %v", i, tt.other, got, tt.want)
+		}
+	}
+
+	below, above := i.SplitAt(5)
+	if below != NewInterval(2, 5) || above != NewInterval(5, 8) {
+		t.Errorf("SplitAt(5) = %s, %s", below, above)
+	}
+	below, above = i.SplitAt(100)
+	if below != i || !above.Empty() {
+		t.Errorf("SplitAt(100) = %s, %s", below, above)
+	}
+
+	if got := NewIntervalLen(10, 3).Shift(-4); got != NewInterval(6, 9) {
+		t.Errorf("Shift = %s", got)
+	}
+}
+
+// randomIntervalSet makes a set and the same set as a map, for checking against
+func randomIntervalSet(r *rand.Rand) (IntervalSet, map[int]bool) {
+	intervals := []Interval{}
+	m := map[int]bool{}
+	for j := r.Intn(5); j > 0; j-- {
+		i := NewIntervalLen(r.Intn(50), r.Intn(15))
+		intervals = append(intervals, i)
+		for n := i.Start; n < i.End; n++ {
+			m[n] = true
+		}
+	}
+	return NewIntervalSet(intervals...), m
+}
+
+func checkIntervalSet(t *testing.T, name string, s IntervalSet, want func(n int) bool) {
+	t.Helper()
+
+	count := 0
+	for n := -5; n < 100; n++ {
+		if s.Contains(n) != want(n) {
+			t.Errorf("%s: Contains(%d) = %t in %s", name, n, s.Contains(n), s)
+		}
+		if want(n) {
+			count++
+		}
+	}
+	if s.Len() != count {
+		t.Errorf("%s: Len = %d, want %d", name, s.Len(), count)
+	}
+
+	// the intervals have to stay sorted, not empty, and not touching
+	intervals := s.Intervals()
+	for j, i := range intervals {
+		if i.Empty() || (j > 0 && intervals[j-1].End >= i.Start) {
+			t.Errorf("%s: intervals aren't kept normalized: %s", name, s)
+		}
+	}
+}
+
+func TestIntervalSet(t *testing.T) {
+	r := rand.New(rand.NewSource(21))
+
+	for i := 0; i < 200; i++ {
+		a, aMap := randomIntervalSet(r)
+		b, bMap := randomIntervalSet(r)
+
+		checkIntervalSet(t, "new", a, func(n int) bool { return aMap[n] })
+		checkIntervalSet(t, "union", a.Union(b), func(n int) bool { return aMap[n] || bMap[n] })
+		checkIntervalSet(t, "intersect", a.Intersect(b), func(n int) bool { return aMap[n] && bMap[n] })
+		checkIntervalSet(t, "subtract", a.Subtract(b), func(n int) bool { return aMap[n] && !bMap[n] })
+		checkIntervalSet(t, "shift", a.Shift(7), func(n int) bool { return aMap[n-7] })
+
+		below, above := a.SplitAt(25)
+		checkIntervalSet(t, "below", below, func(n int) bool { return aMap[n] && n < 25 })
+		checkIntervalSet(t, "above", above, func(n int) bool { return aMap[n] && n >= 25 })
+	}
+
+	s := NewIntervalSet(NewInterval(10, 20), NewInterval(3, 5), NewInterval(20, 22))
+	if got := s.String(); got != "{[3, 5), [10, 22)}" {
+		t.Errorf("got %s", got)
+	}
+	if lo, _ := s.Min(); lo != 3 {
+		t.Errorf("Min = %d, want 3", lo)
+	}
+	if hi, _ := s.Max(); hi != 21 {
+		t.Errorf("Max = %d, want 21", hi)
+	}
+	if _, ok := NewIntervalSet().Min(); ok {
+		t.Error("an empty set shouldn't have a min")
+	}
+}
+
+func TestBox(t *testing.T) {
+	b := Box{NewInterval(0, 10), NewInterval(0, 10), NewInterval(0, 10)}
+	if b.Volume() != 1000 || !b.Contains(0, 9, 5) || b.Contains(0, 10, 5) {
+		t.Errorf("%s has the wrong volume or bounds", b)
+	}
+
+	below, above := b.SplitAt(1, 4)
+	if below.Volume() != 400 || above.Volume() != 600 {
+		t.Errorf("SplitAt = %s, %s", below, above)
+	}
+
+	r := rand.New(rand.NewSource(21))
+	for i := 0; i < 100; i++ {
+		other := Box{}
+		for d := 0; d < 3; d++ {
+			other = append(other, NewIntervalLen(r.Intn(15)-3, r.Intn(10)))
+		}
+
+		pieces := b.Subtract(other)
+		total := 0
+		for j, p := range pieces {
+			total += p.Volume()
+			if !p.Intersect(other).Empty() {
+				t.Errorf("piece %s overlaps %s", p, other)
+			}
+			for _, p2 := range pieces[j+1:] {
+				if !p.Intersect(p2).Empty() {
+					t.Errorf("pieces %s and %s overlap", p, p2)
+				}
+			}
+		}
+		if want := b.Volume() - b.Intersect(other).Volume(); total != want {
+			t.Errorf("%s - %s has volume %d, want %d", b, other, total, want)
+		}
+	}
+}
@@ -7,30 +7,70 @@ import (
 	"os"
 )
 
-func ReadFile(name string) *os.File {
+// ParseError is returned by ForEachLine when a line of input couldn't be handled
+type ParseError struct {
+	// Line is the 1-indexed line number of the offending line
+	Line int
+	Text string
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d %q: %s", e.Line, e.Text, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func OpenFile(name string) (*os.File, error) {
 	f, err := os.Open(name)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "failed to open file: %s\n", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("failed to open file: %w", err)
 	}
-	return f
+	return f, nil
 }
 
-func ExecutePerLine(r io.Reader, f func(line string) error) {
+// ForEachLine calls f on each line of r, stopping at the first error.
+// Errors from f are wrapped in a *ParseError.
+func ForEachLine(r io.Reader, f func(line string) error) error {
 	scanner := bufio.NewScanner(r)
 
+	lineNum := 0
 	for scanner.Scan() {
+		lineNum++
 		line := scanner.Text()
 
-		err := f(line)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "error in parsing func: %s", err)
-			os.Exit(1)
+		if err := f(line); err != nil {
+			return &ParseError{
+				Line: lineNum,
+				Text: line,
+				Err:  err,
+			}
 		}
 	}
 
 	if err := scanner.Err(); err != nil {
-		fmt.Fprintf(os.Stderr, "error reading file: %s", err)
+		return fmt.Errorf("error reading input: %w", err)
+	}
+
+	return nil
+}
+
+// Deprecated: use OpenFile, which returns an error instead of exiting
+func ReadFile(name string) *os.File {
+	f, err := OpenFile(name)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+	return f
+}
+
+// Deprecated: use ForEachLine, which returns an error instead of exiting
+func ExecutePerLine(r io.Reader, f func(line string) error) {
+	if err := ForEachLine(r, f); err != nil {
+		fmt.Fprintf(os.Stderr, "error in parsing func: %s\n", err)
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestForEachLine(t *testing.T) {
+	lines := []string{}
+	err := ForEachLine(strings.NewReader("a\n\nb c\n"), func(line string) error {
+		lines = append(lines, line)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"a", "", "b c"}; !slices.Equal(lines, want) {
+		t.Errorf("got lines %q, want %q", lines, want)
+	}
+}
+
+func TestForEachLineParseError(t *testing.T) {
+	errBad := errors.New("bad line")
+
+	calls := 0
+	err := ForEachLine(strings.NewReader("ok\nok\nnope\nnever reached\n"), func(line string) error {
+		calls++
+		if line == "nope" {
+			return errBad
+		}
+		return nil
+	})
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected a *ParseError, got %v", err)
+	}
+	if parseErr.Line != 3 || parseErr.Text != "nope" {
+		t.Errorf("got line %d %q, want line 3 %q", parseErr.Line, parseErr.Text, "nope")
+	}
+	if !errors.Is(err, errBad) {
+		t.Errorf("expected the error to unwrap to %v, got %v", errBad, err)
+	}
+	if want := `line 3 "nope": bad line`; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+	if calls != 3 {
+		t.Errorf("expected to stop after the bad line, but f was called %d times", calls)
+	}
+}
+
+func TestForEachLineReadError(t *testing.T) {
+	errRead := errors.New("read failed")
+
+	err := ForEachLine(iotest.ErrReader(errRead), func(string) error { return nil })
+	if !errors.Is(err, errRead) {
+		t.Errorf("expected the read error, got %v", err)
+	}
+
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		t.Errorf("a read error shouldn't be a *ParseError, got %v", err)
+	}
+}
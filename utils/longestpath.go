@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	ErrTooManyNodes = errors.New("graph has too many nodes")
+	ErrNoPath       = errors.New("no path between nodes")
+)
+
+// MaxLongestSimplePathNodes is the most nodes LongestSimplePath can handle, since visited nodes are kept in a bitmask
+const MaxLongestSimplePathNodes = 64
+
+type indexedEdge struct {
+	to     int
+	weight int
+}
+
+// longestPathState is a partial path through the graph
+type longestPathState struct {
+	node    int
+	visited uint64
+	length  int
+	// remaining is the sum of maxOut for every node not yet visited
+	remaining int
+	path      []int
+}
+
+// LongestSimplePath finds the longest path from source to dest that never visits a node twice,
+// returning its length and the path. Finding it is NP-hard, so this is a brute force search that
+// prunes any partial path that can't beat the longest found so far, with the first few steps
+// fanned out to goroutines. The graph can have at most MaxLongestSimplePathNodes nodes, which
+// usually means contracting long chains of nodes first.
+func LongestSimplePath[K comparable](g FiniteGraph[K], source, dest K) (int, []K, error) {
+	nodes := g.Nodes()
+	if len(nodes) > MaxLongestSimplePathNodes {
+		return 0, nil, fmt.Errorf("%w: %d nodes, max is %d", ErrTooManyNodes, len(nodes), MaxLongestSimplePathNodes)
+	}
+
+	idxs := make(map[K]int, len(nodes))
+	for i, n := range nodes {
+		idxs[n] = i
+	}
+
+	sourceIdx, ok := idxs[source]
+	if !ok {
+		return 0, nil, fmt.Errorf("%w: source %v is not in the graph", ErrNoPath, source)
+	}
+	destIdx, ok := idxs[dest]
+	if !ok {
+		return 0, nil, fmt.Errorf("%w: dest %v is not in the graph", ErrNoPath, dest)
+	}
+
+	// the longest a path could possibly still get is if it left every unvisited node by its longest edge
+	adj := make([][]indexedEdge, len(nodes))
+	maxOut := make([]int, len(nodes))
+	remaining := 0
+	for i, n := range nodes {
+		for _, edge := range g.Neighbors(n) {
+			to, ok := idxs[edge.To]
+			if !ok || to == i {
+				continue
+			}
+			adj[i] = append(adj[i], indexedEdge{to: to, weight: edge.Weight})
+			maxOut[i] = max(maxOut[i], edge.Weight)
+		}
+		if i != destIdx {
+			remaining += maxOut[i]
+		}
+	}
+
+	search := &longestPathSearch{
+		adj:    adj,
+		maxOut: maxOut,
+		dest:   destIdx,
+	}
+	search.best.Store(-1)
+
+	start := longestPathState{
+		node:      sourceIdx,
+		visited:   1 << sourceIdx,
+		remaining: remaining,
+		path:      []int{sourceIdx},
+	}
+
+	length, path := search.run(start)
+	if length < 0 {
+		return 0, nil, fmt.Errorf("%w: %v to %v", ErrNoPath, source, dest)
+	}
+
+	return length, SliceMap(path, func(i int) K { return nodes[i] }), nil
+}
+
+type longestPathSearch struct {
+	adj    [][]indexedEdge
+	maxOut []int
+	dest   int
+	// best is the longest length found by any goroutine so far, for pruning
+	best atomic.Int64
+}
+
+// run expands the first few levels of the search until there's enough partial paths to keep every
+// cpu busy, then searches the rest of each of them in parallel
+func (s *longestPathSearch) run(start longestPathState) (int, []int) {
+	workers := runtime.GOMAXPROCS(0)
+
+	bestLen, bestPath := -1, []int(nil)
+	record := func(length int, path []int) {
+		if length > bestLen {
+			bestLen, bestPath = length, path
+			s.raiseBest(length)
+		}
+	}
+
+	frontier := []longestPathState{start}
+	for len(frontier) > 0 && len(frontier) < workers*4 {
+		next := []longestPathState{}
+		for _, state := range frontier {
+			if state.node == s.dest {
+				record(state.length, state.path)
+				continue
+			}
+			next = append(next, s.expand(state)...)
+		}
+		frontier = next
+	}
+
+	type result struct {
+		length int
+		path   []int
+	}
+
+	jobs := make(chan longestPathState)
+	results := make(chan result, workers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			r := result{length: -1}
+			for state := range jobs {
+				if length, path := s.dfs(state); length > r.length {
+					r = result{length, path}
+				}
+			}
+			results <- r
+		}()
+	}
+
+	for _, state := range frontier {
+		jobs <- state
+	}
+	close(jobs)
+	wg.Wait()
+	close(results)
+
+	for r := range results {
+		record(r.length, r.path)
+	}
+
+	return bestLen, bestPath
+}
+
+// expand returns every state one step further along from state
+func (s *longestPathSearch) expand(state longestPathState) []longestPathState {
+	next := []longestPathState{}
+	for _, edge := range s.adj[state.node] {
+		if state.visited&(1<<edge.to) != 0 {
+			continue
+		}
+
+		path := make([]int, len(state.path), len(state.path)+1)
+		copy(path, state.path)
+
+		next = append(next, longestPathState{
+			node:      edge.to,
+			visited:   state.visited | 1<<edge.to,
+			length:    state.length + edge.weight,
+			remaining: state.remaining - s.maxOut[state.node],
+			path:      append(path, edge.to),
+		})
+	}
+	return next
+}
+
+// dfs finds the longest way to finish the path in state
+func (s *longestPathSearch) dfs(state longestPathState) (int, []int) {
+	bestLen := -1
+	var bestPath []int
+
+	path := make([]int, len(state.path), len(state.path)+bits.OnesCount64(^state.visited))
+	copy(path, state.path)
+
+	var visit func(node int, visited uint64, length, remaining int)
+	visit = func(node int, visited uint64, length, remaining int) {
+		if node == s.dest {
+			if length > bestLen {
+				bestLen = length
+				bestPath = make([]int, len(path))
+				copy(bestPath, path)
+				s.raiseBest(length)
+			}
+			return
+		}
+
+		if length+remaining <= int(s.best.Load()) {
+			// can't beat what's already been found
+			return
+		}
+
+		remaining -= s.maxOut[node]
+		for _, edge := range s.adj[node] {
+			if visited&(1<<edge.to) != 0 {
+				continue
+			}
+
+			path = append(path, edge.to)
+			visit(edge.to, visited|1<<edge.to, length+edge.weight, remaining)
+			path = path[:len(path)-1]
+		}
+	}
+
+	visit(state.node, state.visited, state.length, state.remaining)
+
+	return bestLen, bestPath
+}
+
+func (s *longestPathSearch) raiseBest(length int) {
+	for {
+		cur := s.best.Load()
+		if int64(length) <= cur || s.best.CompareAndSwap(cur, int64(length)) {
+			return
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// bruteForceLongestPath tries every simple path from source to dest
+func bruteForceLongestPath(cMap ConnectionMap[int], source, dest int) int {
+	longest := -1
+
+	var dfs func(node int, visited map[int]bool, length int)
+	dfs = func(node int, visited map[int]bool, length int) {
+		if node == dest {
+			longest = max(longest, length)
+			return
+		}
+
+		visited[node] = true
+		for neighbor, weight := range cMap[node] {
+			if !visited[neighbor] {
+				dfs(neighbor, visited, length+weight)
+			}
+		}
+		visited[node] = false
+	}
+
+	dfs(source, map[int]bool{}, 0)
+	return longest
+}
+
+func randomConnectionMap(r *rand.Rand, numNodes int, edgeChance float64, directed bool) ConnectionMap[int] {
+	cMap := ConnectionMap[int]{}
+	for i := 0; i < numNodes; i++ {
+		cMap[i] = map[int]int{}
+	}
+
+	for i := 0; i < numNodes; i++ {
+		for j := 0; j < numNodes; j++ {
+			if i == j || r.Float64() > edgeChance {
+				continue
+			}
+			if !directed && j < i {
+				continue
+			}
+
+			weight := 1 + r.Intn(20)
+			cMap[i][j] = weight
+			if !directed {
+				cMap[j][i] = weight
+			}
+		}
+	}
+
+	return cMap
+}
+
+func TestLongestSimplePath(t *testing.T) {
+	r := rand.New(rand.NewSource(23))
+
+	for i := 0; i < 50; i++ {
+		directed := i%2 == 0
+		numNodes := 2 + r.Intn(9)
+		cMap := randomConnectionMap(r, numNodes, 0.4, directed)
+		source, dest := 0, numNodes-1
+
+		name := fmt.Sprintf("graph%d", i)
+		want := bruteForceLongestPath(cMap, source, dest)
+
+		length, path, err := LongestSimplePath[int](cMap, source, dest)
+		if want < 0 {
+			if !errors.Is(err, ErrNoPath) {
+				t.Errorf("%s: expected ErrNoPath, got %d, %v, %v", name, length, path, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+
+		if length != want {
+			t.Errorf("%s: got length %d, want %d", name, length, want)
+		}
+
+		if path[0] != source || path[len(path)-1] != dest {
+			t.Errorf("%s: path %v doesn't go from source to dest", name, path)
+		}
+		seen := map[int]bool{}
+		pathLen := 0
+		for j, node := range path {
+			if seen[node] {
+				t.Errorf("%s: path %v visits %d twice", name, path, node)
+			}
+			seen[node] = true
+			if j > 0 {
+				weight, ok := cMap[path[j-1]][node]
+				if !ok {
+					t.Errorf("%s: path %v uses an edge that doesn't exist", name, path)
+				}
+				pathLen += weight
+			}
+		}
+		if pathLen != length {
+			t.Errorf("%s: path %v has length %d, want %d", name, path, pathLen, length)
+		}
+	}
+}
+
+func TestLongestSimplePathErrors(t *testing.T) {
+	cMap := ConnectionMap[int]{}
+	for i := 0; i <= MaxLongestSimplePathNodes; i++ {
+		cMap[i] = map[int]int{i + 1: 1}
+	}
+	if _, _, err := LongestSimplePath[int](cMap, 0, 1); !errors.Is(err, ErrTooManyNodes) {
+		t.Errorf("expected ErrTooManyNodes, got %v", err)
+	}
+
+	cMap = ConnectionMap[int]{0: {1: 1}, 1: {}}
+	if _, _, err := LongestSimplePath[int](cMap, 0, 5); !errors.Is(err, ErrNoPath) {
+		t.Errorf("expected ErrNoPath, got %v", err)
+	}
+
+	length, path, err := LongestSimplePath[int](cMap, 0, 0)
+	if err != nil || length != 0 || len(path) != 1 {
+		t.Errorf("path from a node to itself = %d, %v, %v", length, path, err)
+	}
+}
+
+func BenchmarkLongestSimplePath(b *testing.B) {
+	// a 6x6 grid is about the size of day 23's graph once its corridors are contracted
+	cMap := ConnectionMap[int]{}
+	size := 6
+	for i := 0; i < size*size; i++ {
+		cMap[i] = map[int]int{}
+	}
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			i := y*size + x
+			if x+1 < size {
+				cMap[i][i+1] = 1 + (i*7)%13
+				cMap[i+1][i] = 1 + (i*7)%13
+			}
+			if y+1 < size {
+				cMap[i][i+size] = 1 + (i*11)%13
+				cMap[i+size][i] = 1 + (i*11)%13
+			}
+		}
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		LongestSimplePath[int](cMap, 0, size*size-1)
+	}
+}
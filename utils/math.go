@@ -1,21 +1,135 @@
 package utils
 
-func LeastCommonMultiple(nums []int) int {
-	dedupFactors := map[int]any{}
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
 
+var (
+	ErrOverflow   = errors.New("integer overflow")
+	ErrNoSolution = errors.New("no solution")
+)
+
+// GCD is the greatest common divisor of a and b, which is never negative
+func GCD(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// ExtendedGCD returns the gcd of a and b along with x and y such that a*x + b*y = gcd
+func ExtendedGCD(a, b int) (gcd, x, y int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// LeastCommonMultiple returns ErrOverflow if the lcm doesn't fit in an int
+func LeastCommonMultiple(nums []int) (int, error) {
+	lcm := 1
 	for _, n := range nums {
-		factors := PrimeFactorization(n)
-		for _, f := range factors {
-			dedupFactors[f] = nil
+		if n == 0 {
+			return 0, nil
+		}
+		if n < 0 {
+			n = -n
+		}
+
+		var err error
+		lcm, err = CheckedMul(lcm/GCD(lcm, n), n)
+		if err != nil {
+			return 0, fmt.Errorf("lcm of %v: %w", nums, err)
 		}
 	}
 
-	lcm := 1
-	for f := range dedupFactors {
-		lcm *= f
+	return lcm, nil
+}
+
+// ModInverse returns x such that a*x = 1 (mod m), in the range [0, m)
+func ModInverse(a, m int) (int, error) {
+	if m <= 0 {
+		return 0, fmt.Errorf("modulus must be positive, got %d", m)
+	}
+
+	gcd, x, _ := ExtendedGCD(mod(a, m), m)
+	if gcd != 1 {
+		return 0, fmt.Errorf("%d has no inverse mod %d: %w", a, m, ErrNoSolution)
+	}
+
+	return mod(x, m), nil
+}
+
+// ChineseRemainder finds the smallest x >= 0 with x = remainders[i] (mod moduli[i]) for every i.
+// It returns x along with the lcm of the moduli, which every other solution is x plus a multiple of.
+// The moduli don't need to be coprime, but if they aren't there might be no solution.
+func ChineseRemainder(remainders, moduli []int) (x, lcm int, err error) {
+	if len(remainders) != len(moduli) {
+		return 0, 0, fmt.Errorf("got %d remainders and %d moduli", len(remainders), len(moduli))
 	}
 
-	return lcm
+	x, lcm = 0, 1
+	for i, m := range moduli {
+		if m <= 0 {
+			return 0, 0, fmt.Errorf("modulus must be positive, got %d", m)
+		}
+		r := mod(remainders[i], m)
+
+		// x + lcm*k = r (mod m), so lcm*k = r - x (mod m)
+		gcd := GCD(lcm, m)
+		diff := r - mod(x, m)
+		if diff%gcd != 0 {
+			return 0, 0, fmt.Errorf("x = %d (mod %d) and x = %d (mod %d): %w", x, lcm, r, m, ErrNoSolution)
+		}
+
+		mg := m / gcd
+		inv, err := ModInverse(lcm/gcd, mg)
+		if err != nil {
+			return 0, 0, err
+		}
+		k := mulMod(diff/gcd, inv, mg)
+
+		newLCM, err := CheckedMul(lcm/gcd, m)
+		if err != nil {
+			return 0, 0, fmt.Errorf("lcm of %v: %w", moduli, err)
+		}
+		// x < lcm and k < m/gcd, so x + lcm*k < newLCM and this can't overflow
+		x += lcm * k
+		lcm = newLCM
+	}
+
+	return x, lcm, nil
+}
+
+// mod is a % m, but never negative
+func mod(a, m int) int {
+	a %= m
+	if a < 0 {
+		a += m
+	}
+	return a
+}
+
+// mulMod is a*b mod m without overflowing along the way
+func mulMod(a, b, m int) int {
+	n := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(b)))
+	return int(n.Mod(n, big.NewInt(int64(m))).Int64())
 }
 
 func PrimeFactorization(num int) []int {
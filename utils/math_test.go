@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestGCD(t *testing.T) {
+	tests := []struct {
+		a, b, want int
+	}{
+		{12, 18, 6},
+		{18, 12, 6},
+		{7, 13, 1},
+		{0, 5, 5},
+		{5, 0, 5},
+		{-12, 18, 6},
+		{12, -18, 6},
+	}
+
+	for _, tt := range tests {
+		if got := GCD(tt.a, tt.b); got != tt.want {
+			t.Errorf("GCD(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+
+		gcd, x, y := ExtendedGCD(tt.a, tt.b)
+		if gcd != tt.want || tt.a*x+tt.b*y != gcd {
+			t.Errorf("ExtendedGCD(%d, %d) = %d, %d, %d", tt.a, tt.b, gcd, x, y)
+		}
+	}
+}
+
+func TestLeastCommonMultiple(t *testing.T) {
+	tests := []struct {
+		nums []int
+		want int
+	}{
+		{[]int{4, 6}, 12},
+		{[]int{2, 4, 8}, 8},
+		{[]int{3, 5, 7}, 105},
+		{[]int{12, 18, 30}, 180},
+		{[]int{5}, 5},
+		{[]int{5, 0}, 0},
+		{[]int{}, 1},
+	}
+
+	for _, tt := range tests {
+		got, err := LeastCommonMultiple(tt.nums)
+		if err != nil {
+			t.Errorf("LeastCommonMultiple(%v): unexpected error: %s", tt.nums, err)
+		}
+		if got != tt.want {
+			t.Errorf("LeastCommonMultiple(%v) = %d, want %d", tt.nums, got, tt.want)
+		}
+	}
+
+	if _, err := LeastCommonMultiple([]int{math.MaxInt, math.MaxInt - 1}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", err)
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	inv, err := ModInverse(3, 11)
+	if err != nil || inv != 4 {
+		t.Errorf("ModInverse(3, 11) = %d, %v, want 4", inv, err)
+	}
+
+	inv, err = ModInverse(-3, 11)
+	if err != nil || inv != 7 {
+		t.Errorf("ModInverse(-3, 11) = %d, %v, want 7", inv, err)
+	}
+
+	if _, err := ModInverse(4, 10); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("expected ErrNoSolution, got %v", err)
+	}
+}
+
+func TestChineseRemainder(t *testing.T) {
+	tests := []struct {
+		remainders, moduli []int
+		want, wantLCM      int
+	}{
+		{[]int{2, 3, 2}, []int{3, 5, 7}, 23, 105},
+		{[]int{0, 0}, []int{4, 6}, 0, 12},
+		{[]int{1, 3}, []int{4, 6}, 9, 12},
+		{[]int{-1, 0}, []int{5, 3}, 9, 15},
+		{[]int{}, []int{}, 0, 1},
+	}
+
+	for _, tt := range tests {
+		x, lcm, err := ChineseRemainder(tt.remainders, tt.moduli)
+		if err != nil {
+			t.Errorf("ChineseRemainder(%v, %v): unexpected error: %s", tt.remainders, tt.moduli, err)
+			continue
+		}
+		if x != tt.want || lcm != tt.wantLCM {
+			t.Errorf("ChineseRemainder(%v, %v) = %d, %d, want %d, %d", tt.remainders, tt.moduli, x, lcm, tt.want, tt.wantLCM)
+		}
+	}
+
+	if _, _, err := ChineseRemainder([]int{1, 2}, []int{4, 6}); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("expected ErrNoSolution, got %v", err)
+	}
+}
+
+func BenchmarkNevilleInterpolation(b *testing.B) {
+	xs := make([]int, 21)
+	ys := make([]int, 21)
+	for i := range xs {
+		xs[i] = i
+		ys[i] = 3*i*i - 2*i + 7
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NevilleInterpolation(xs, ys, len(xs))
+	}
+}
@@ -0,0 +1,245 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+var (
+	ErrTooFewNodes  = errors.New("graph has too few nodes")
+	ErrNodeNotFound = errors.New("node is not in the graph")
+)
+
+// Cut splits a graph's nodes into two sides
+type Cut[K comparable] struct {
+	// Weight is the total weight of the edges between the sides
+	Weight int
+	// Edges are the edges between the sides, each going from a node in A to a node in B
+	Edges [][2]K
+	A, B  []K
+}
+
+// MinCut finds the cheapest set of edges to cut to split the graph in two, using Stoer-Wagner.
+// The graph is treated as undirected, so an edge only listed one way still counts, and if the two
+// ways have different weights the larger one is used.
+func (cMap ConnectionMap[K]) MinCut() (Cut[K], error) {
+	nodes, idxs := cMap.indexNodes()
+	if len(nodes) < 2 {
+		return Cut[K]{}, fmt.Errorf("%w: min cut needs at least 2, got %d", ErrTooFewNodes, len(nodes))
+	}
+
+	adj := make([]map[int]int, len(nodes))
+	for i := range adj {
+		adj[i] = map[int]int{}
+	}
+	for node, neighbors := range cMap {
+		u := idxs[node]
+		for neighbor, weight := range neighbors {
+			v := idxs[neighbor]
+			if u != v && weight > adj[u][v] {
+				adj[u][v] = weight
+				adj[v][u] = weight
+			}
+		}
+	}
+
+	// each merged node stands in for a group of the original nodes
+	groups := make([][]int, len(nodes))
+	active := make([]int, len(nodes))
+	for i := range nodes {
+		groups[i] = []int{i}
+		active[i] = i
+	}
+
+	bestWeight := math.MaxInt
+	var bestGroup []int
+
+	added := make([]bool, len(nodes))
+	connectivity := make([]int, len(nodes))
+
+	for len(active) > 1 {
+		// add nodes one at a time, always picking the one most tightly connected to what's been added
+		pq := NewPriorityQueue[int, int]()
+		pq.SetPriorityOrder(true)
+		for _, v := range active {
+			added[v] = false
+			connectivity[v] = 0
+			pq.Push(v, 0)
+		}
+
+		prev, last, lastWeight := -1, -1, 0
+		for pq.Len() > 0 {
+			v, weight := pq.Pop()
+			added[v] = true
+			prev, last, lastWeight = last, v, weight
+
+			for u, w := range adj[v] {
+				if !added[u] {
+					connectivity[u] += w
+					pq.Update(u, connectivity[u])
+				}
+			}
+		}
+
+		// cutting off the last node added is the min cut between it and the one added before it
+		if lastWeight < bestWeight {
+			bestWeight = lastWeight
+			bestGroup = slices.Clone(groups[last])
+		}
+
+		// merge the last two nodes together
+		for u, w := range adj[last] {
+			delete(adj[u], last)
+			if u != prev {
+				adj[prev][u] += w
+				adj[u][prev] += w
+			}
+		}
+		adj[last] = nil
+		groups[prev] = append(groups[prev], groups[last]...)
+		active = slices.DeleteFunc(active, func(v int) bool { return v == last })
+	}
+
+	inA := make([]bool, len(nodes))
+	for _, i := range bestGroup {
+		inA[i] = true
+	}
+	return cMap.cutFromSides(nodes, idxs, inA, false), nil
+}
+
+// MaxFlow finds the most that can flow from source to sink, using each edge's weight as how much it
+// can carry, with Edmonds-Karp. The cut is the cheapest set of edges that separates source from sink,
+// whose weight is always the max flow.
+func (cMap ConnectionMap[K]) MaxFlow(source, sink K) (int, Cut[K], error) {
+	nodes, idxs := cMap.indexNodes()
+
+	s, ok := idxs[source]
+	if !ok {
+		return 0, Cut[K]{}, fmt.Errorf("%w: source %v", ErrNodeNotFound, source)
+	}
+	t, ok := idxs[sink]
+	if !ok {
+		return 0, Cut[K]{}, fmt.Errorf("%w: sink %v", ErrNodeNotFound, sink)
+	}
+	if s == t {
+		return 0, Cut[K]{}, fmt.Errorf("%w: source and sink are both %v", ErrTooFewNodes, source)
+	}
+
+	// residual has how much more can flow along each edge, including backwards along ones that have flow
+	residual := make([]map[int]int, len(nodes))
+	for i := range residual {
+		residual[i] = map[int]int{}
+	}
+	for node, neighbors := range cMap {
+		u := idxs[node]
+		for neighbor, weight := range neighbors {
+			v := idxs[neighbor]
+			if u == v {
+				continue
+			}
+			residual[u][v] += weight
+			if _, ok := residual[v][u]; !ok {
+				residual[v][u] = 0
+			}
+		}
+	}
+
+	prev := make([]int, len(nodes))
+	reachable := func() bool {
+		for i := range prev {
+			prev[i] = -1
+		}
+		prev[s] = s
+
+		queue := NewQueue[int]()
+		queue.Push(s)
+		for queue.Len() > 0 {
+			u := queue.Pop()
+			for v, capacity := range residual[u] {
+				if capacity > 0 && prev[v] < 0 {
+					prev[v] = u
+					if v == t {
+						return true
+					}
+					queue.Push(v)
+				}
+			}
+		}
+		return false
+	}
+
+	flow := 0
+	for reachable() {
+		bottleneck := math.MaxInt
+		for v := t; v != s; v = prev[v] {
+			bottleneck = min(bottleneck, residual[prev[v]][v])
+		}
+		for v := t; v != s; v = prev[v] {
+			residual[prev[v]][v] -= bottleneck
+			residual[v][prev[v]] += bottleneck
+		}
+		flow += bottleneck
+	}
+
+	// whatever the source can still reach is its side of the cut
+	inA := make([]bool, len(nodes))
+	for i, p := range prev {
+		inA[i] = p >= 0
+	}
+	return flow, cMap.cutFromSides(nodes, idxs, inA, true), nil
+}
+
+// indexNodes numbers every node in the graph, including ones that are only ever edge destinations
+func (cMap ConnectionMap[K]) indexNodes() ([]K, map[K]int) {
+	nodes := []K{}
+	idxs := map[K]int{}
+
+	add := func(node K) {
+		if _, ok := idxs[node]; !ok {
+			idxs[node] = len(nodes)
+			nodes = append(nodes, node)
+		}
+	}
+	for node, neighbors := range cMap {
+		add(node)
+		for neighbor := range neighbors {
+			add(neighbor)
+		}
+	}
+
+	return nodes, idxs
+}
+
+func (cMap ConnectionMap[K]) cutFromSides(nodes []K, idxs map[K]int, inA []bool, directed bool) Cut[K] {
+	cut := Cut[K]{}
+	for i, node := range nodes {
+		if inA[i] {
+			cut.A = append(cut.A, node)
+		} else {
+			cut.B = append(cut.B, node)
+		}
+	}
+
+	// undirected edges can be listed both ways, but should only count once at their larger weight
+	weights := map[[2]K]int{}
+	for node, neighbors := range cMap {
+		for neighbor, weight := range neighbors {
+			from, to := inA[idxs[node]], inA[idxs[neighbor]]
+			switch {
+			case from && !to:
+				weights[[2]K{node, neighbor}] = max(weights[[2]K{node, neighbor}], weight)
+			case !from && to && !directed:
+				weights[[2]K{neighbor, node}] = max(weights[[2]K{neighbor, node}], weight)
+			}
+		}
+	}
+
+	for edge, weight := range weights {
+		cut.Edges = append(cut.Edges, edge)
+		cut.Weight += weight
+	}
+
+	return cut
+}
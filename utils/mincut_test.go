@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// bruteForceCut tries every way to split the nodes in two, only counting splits with source on one
+// side and sink on the other if they're given
+func bruteForceCut(cMap ConnectionMap[int], numNodes int, directed bool, source, sink int) int {
+	best := math.MaxInt
+	for mask := 1; mask < 1<<numNodes-1; mask++ {
+		inA := func(n int) bool { return mask&(1<<n) != 0 }
+		if source >= 0 && (!inA(source) || inA(sink)) {
+			continue
+		}
+
+		weight := 0
+		for u, neighbors := range cMap {
+			for v, w := range neighbors {
+				if inA(u) && !inA(v) {
+					weight += w
+				}
+				if !directed && !inA(u) && inA(v) {
+					weight += w
+				}
+			}
+		}
+		if !directed {
+			// every edge is listed both ways
+			weight /= 2
+		}
+		best = min(best, weight)
+	}
+	return best
+}
+
+func checkCut(t *testing.T, name string, cMap ConnectionMap[int], cut Cut[int], directed bool) {
+	t.Helper()
+
+	inA := map[int]bool{}
+	for _, n := range cut.A {
+		inA[n] = true
+	}
+	if len(cut.A) == 0 || len(cut.B) == 0 {
+		t.Errorf("%s: one side of the cut is empty: %v", name, cut)
+	}
+
+	weight := 0
+	for _, edge := range cut.Edges {
+		if !inA[edge[0]] || inA[edge[1]] {
+			t.Errorf("%s: edge %v doesn't go from A to B", name, edge)
+		}
+		w, ok := cMap[edge[0]][edge[1]]
+		if !ok && !directed {
+			w, ok = cMap[edge[1]][edge[0]]
+		}
+		if !ok {
+			t.Errorf("%s: edge %v isn't in the graph", name, edge)
+		}
+		weight += w
+	}
+	if weight != cut.Weight {
+		t.Errorf("%s: cut edges weigh %d, but the cut says %d", name, weight, cut.Weight)
+	}
+}
+
+func TestMinCut(t *testing.T) {
+	r := rand.New(rand.NewSource(25))
+
+	for i := 0; i < 50; i++ {
+		numNodes := 2 + r.Intn(9)
+		cMap := randomConnectionMap(r, numNodes, 0.5, false)
+		name := fmt.Sprintf("graph%d", i)
+
+		cut, err := cMap.MinCut()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+
+		if want := bruteForceCut(cMap, numNodes, false, -1, -1); cut.Weight != want {
+			t.Errorf("%s: got weight %d, want %d", name, cut.Weight, want)
+		}
+		checkCut(t, name, cMap, cut, false)
+	}
+
+	if _, err := (ConnectionMap[int]{0: {}}).MinCut(); !errors.Is(err, ErrTooFewNodes) {
+		t.Errorf("expected ErrTooFewNodes, got %v", err)
+	}
+}
+
+func TestMinCutTwoCliques(t *testing.T) {
+	// two groups of 6 where everything is connected, with 3 edges joining them
+	cMap := ConnectionMap[int]{}
+	connect := func(a, b int) {
+		if _, ok := cMap[a]; !ok {
+			cMap[a] = map[int]int{}
+		}
+		if _, ok := cMap[b]; !ok {
+			cMap[b] = map[int]int{}
+		}
+		cMap[a][b] = 1
+		cMap[b][a] = 1
+	}
+	for offset := 0; offset <= 6; offset += 6 {
+		for a := 0; a < 6; a++ {
+			for b := a + 1; b < 6; b++ {
+				connect(offset+a, offset+b)
+			}
+		}
+	}
+	for i := 0; i < 3; i++ {
+		connect(i, 6+i)
+	}
+
+	cut, err := cMap.MinCut()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cut.Weight != 3 || len(cut.Edges) != 3 || len(cut.A) != 6 || len(cut.B) != 6 {
+		t.Errorf("got cut %v", cut)
+	}
+}
+
+func TestMaxFlow(t *testing.T) {
+	r := rand.New(rand.NewSource(25))
+
+	for i := 0; i < 50; i++ {
+		numNodes := 2 + r.Intn(9)
+		cMap := randomConnectionMap(r, numNodes, 0.5, true)
+		name := fmt.Sprintf("graph%d", i)
+		source, sink := 0, numNodes-1
+
+		flow, cut, err := cMap.MaxFlow(source, sink)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+
+		if want := bruteForceCut(cMap, numNodes, true, source, sink); flow != want {
+			t.Errorf("%s: got flow %d, want %d", name, flow, want)
+		}
+		if flow != cut.Weight {
+			t.Errorf("%s: flow is %d but the cut weighs %d", name, flow, cut.Weight)
+		}
+		checkCut(t, name, cMap, cut, true)
+	}
+
+	cMap := ConnectionMap[int]{0: {1: 1}}
+	if _, _, err := cMap.MaxFlow(0, 5); !errors.Is(err, ErrNodeNotFound) {
+		t.Errorf("expected ErrNodeNotFound, got %v", err)
+	}
+	if _, _, err := cMap.MaxFlow(0, 0); !errors.Is(err, ErrTooFewNodes) {
+		t.Errorf("expected ErrTooFewNodes, got %v", err)
+	}
+}
+
+func BenchmarkMinCut(b *testing.B) {
+	for _, size := range []int{10, 20, 30} {
+		cMap := gridConnectionMap(size)
+
+		b.Run(fmt.Sprintf("grid%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				cMap.MinCut()
+			}
+		})
+	}
+}
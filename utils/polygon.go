@@ -0,0 +1,126 @@
+package utils
+
+import "math"
+
+// Polygon is a closed shape on the grid, with an edge from each vertex to the next and one from the
+// last back to the first
+type Polygon []Coordinate
+
+func NewPolygon(vertices ...Coordinate) Polygon {
+	return Polygon(vertices)
+}
+
+// PolygonStep is a straight line of Len steps in Dir
+type PolygonStep struct {
+	Dir Direction
+	Len int
+}
+
+// NewPolygonFromSteps walks the steps from start, putting a vertex at the end of each one. The steps
+// should lead back around to start, which isn't repeated at the end.
+func NewPolygonFromSteps(start Coordinate, steps ...PolygonStep) Polygon {
+	p := Polygon{start}
+	coor := start
+	for _, step := range steps {
+		coor = coor.Add(Coordinate(step.Dir).Scale(step.Len))
+		p = append(p, coor)
+	}
+
+	if len(p) > 1 && p[len(p)-1] == start {
+		p = p[:len(p)-1]
+	}
+	return p
+}
+
+// edges calls f with the start and end of every edge
+func (p Polygon) edges(f func(a, b Coordinate)) {
+	for i, a := range p {
+		f(a, p[(i+1)%len(p)])
+	}
+}
+
+// DoubleSignedArea is twice the signed area, which is always a whole number on the grid
+func (p Polygon) DoubleSignedArea() int {
+	// https://en.wikipedia.org/wiki/Shoelace_formula
+	area := 0
+	p.edges(func(a, b Coordinate) {
+		area += a.Cross(b)
+	})
+	return area
+}
+
+// SignedArea is positive if the vertices go clockwise on screen, where Y goes down, and negative
+// if they go counterclockwise
+func (p Polygon) SignedArea() float64 {
+	return float64(p.DoubleSignedArea()) / 2
+}
+
+func (p Polygon) Area() float64 {
+	return math.Abs(p.SignedArea())
+}
+
+func (p Polygon) Perimeter() float64 {
+	perimeter := 0.0
+	p.edges(func(a, b Coordinate) {
+		d := b.Sub(a)
+		perimeter += math.Hypot(float64(d.X), float64(d.Y))
+	})
+	return perimeter
+}
+
+// BoundaryPoints is how many grid points are on the edges. For a polygon made of only horizontal
+// and vertical edges, that's the same as the perimeter.
+func (p Polygon) BoundaryPoints() int {
+	points := 0
+	p.edges(func(a, b Coordinate) {
+		d := b.Sub(a)
+		points += GCD(d.X, d.Y)
+	})
+	return points
+}
+
+// InteriorPoints is how many grid points are strictly inside, using Pick's theorem
+func (p Polygon) InteriorPoints() int {
+	// https://en.wikipedia.org/wiki/Pick%27s_theorem
+	// A = I + B/2 - 1, so I = (2A - B + 2) / 2
+	return (abs(p.DoubleSignedArea()) - p.BoundaryPoints() + 2) / 2
+}
+
+// EnclosedPoints is how many grid points are inside or on the edges
+func (p Polygon) EnclosedPoints() int {
+	return p.InteriorPoints() + p.BoundaryPoints()
+}
+
+// OnBoundary is if c is on one of the edges
+func (p Polygon) OnBoundary(c Coordinate) bool {
+	onBoundary := false
+	p.edges(func(a, b Coordinate) {
+		// c has to be on the line through a and b, and inside the box they make
+		if b.Sub(a).Cross(c.Sub(a)) == 0 && c.Max(a.Min(b)).Min(a.Max(b)) == c {
+			onBoundary = true
+		}
+	})
+	return onBoundary
+}
+
+// Contains is if c is strictly inside, using the even-odd rule. Points on the edges aren't
+// contained, so check OnBoundary for those.
+func (p Polygon) Contains(c Coordinate) bool {
+	if p.OnBoundary(c) {
+		return false
+	}
+
+	// count how many edges a ray going right from c crosses
+	inside := false
+	p.edges(func(a, b Coordinate) {
+		if (a.Y > c.Y) == (b.Y > c.Y) {
+			return
+		}
+		// c is left of where the edge crosses its row when this has the same sign as the edge's change in Y
+		side := b.Sub(a).Cross(c.Sub(a))
+		if (side > 0) == (b.Y > a.Y) {
+			inside = !inside
+		}
+	})
+	return inside
+}
@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPolygon(t *testing.T) {
+	tests := []struct {
+		name      string
+		polygon   Polygon
+		area      float64
+		perimeter float64
+		boundary  int
+		interior  int
+	}{
+		{
+			name:      "square clockwise",
+			polygon:   NewPolygon(NewCoordinate(0, 0), NewCoordinate(4, 0), NewCoordinate(4, 4), NewCoordinate(0, 4)),
+			area:      16,
+			perimeter: 16,
+			boundary:  16,
+			interior:  9,
+		},
+		{
+			name:      "square counterclockwise",
+			polygon:   NewPolygon(NewCoordinate(0, 0), NewCoordinate(0, 4), NewCoordinate(4, 4), NewCoordinate(4, 0)),
+			area:      -16,
+			perimeter: 16,
+			boundary:  16,
+			interior:  9,
+		},
+		{
+			name:      "triangle",
+			polygon:   NewPolygon(NewCoordinate(0, 0), NewCoordinate(4, 0), NewCoordinate(0, 3)),
+			area:      6,
+			perimeter: 12,
+			boundary:  4 + 3 + 1,
+			interior:  3,
+		},
+		{
+			name: "L shape from steps",
+			polygon: NewPolygonFromSteps(NewCoordinate(1, 1),
+				PolygonStep{RIGHT, 2}, PolygonStep{DOWN, 1}, PolygonStep{RIGHT, 2}, PolygonStep{DOWN, 2},
+				PolygonStep{LEFT, 4}, PolygonStep{UP, 3},
+			),
+			area:      10,
+			perimeter: 14,
+			boundary:  14,
+			interior:  4,
+		},
+		{
+			name:      "collinear vertices",
+			polygon:   NewPolygon(NewCoordinate(0, 0), NewCoordinate(1, 0), NewCoordinate(2, 0), NewCoordinate(2, 2), NewCoordinate(0, 2)),
+			area:      4,
+			perimeter: 8,
+			boundary:  8,
+			interior:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.polygon.SignedArea(); got != tt.area {
+				t.Errorf("SignedArea() = %v, want %v", got, tt.area)
+			}
+			if got := tt.polygon.Area(); got != math.Abs(tt.area) {
+				t.Errorf("Area() = %v, want %v", got, math.Abs(tt.area))
+			}
+			if got := tt.polygon.Perimeter(); got != tt.perimeter {
+				t.Errorf("Perimeter() = %v, want %v", got, tt.perimeter)
+			}
+			if got := tt.polygon.BoundaryPoints(); got != tt.boundary {
+				t.Errorf("BoundaryPoints() = %d, want %d", got, tt.boundary)
+			}
+			if got := tt.polygon.InteriorPoints(); got != tt.interior {
+				t.Errorf("InteriorPoints() = %d, want %d", got, tt.interior)
+			}
+			if got := tt.polygon.EnclosedPoints(); got != tt.interior+tt.boundary {
+				t.Errorf("EnclosedPoints() = %d, want %d", got, tt.interior+tt.boundary)
+			}
+
+			// Contains and OnBoundary have to agree with the counts from Pick's theorem
+			interior, boundary := 0, 0
+			for y := -1; y <= 6; y++ {
+				for x := -1; x <= 6; x++ {
+					c := NewCoordinate(x, y)
+					if tt.polygon.Contains(c) {
+						interior++
+					}
+					if tt.polygon.OnBoundary(c) {
+						boundary++
+					}
+				}
+			}
+			if interior != tt.interior {
+				t.Errorf("Contains() is true for %d points, want %d", interior, tt.interior)
+			}
+			if boundary != tt.boundary {
+				t.Errorf("OnBoundary() is true for %d points, want %d", boundary, tt.boundary)
+			}
+		})
+	}
+}
+
+func TestPolygonFromStepsClosesLoop(t *testing.T) {
+	p := NewPolygonFromSteps(NewCoordinate(0, 0), PolygonStep{RIGHT, 3}, PolygonStep{DOWN, 3}, PolygonStep{LEFT, 3}, PolygonStep{UP, 3})
+	want := NewPolygon(NewCoordinate(0, 0), NewCoordinate(3, 0), NewCoordinate(3, 3), NewCoordinate(0, 3))
+
+	if len(p) != len(want) {
+		t.Fatalf("got %v, want %v", p, want)
+	}
+	for i := range p {
+		if p[i] != want[i] {
+			t.Fatalf("got %v, want %v", p, want)
+		}
+	}
+}
+
+func TestPolygonContainsConcave(t *testing.T) {
+	// a U shape, where the ray from the gap in the middle crosses both arms
+	p := NewPolygon(
+		NewCoordinate(0, 0), NewCoordinate(2, 0), NewCoordinate(2, 4), NewCoordinate(4, 4),
+		NewCoordinate(4, 0), NewCoordinate(6, 0), NewCoordinate(6, 6), NewCoordinate(0, 6),
+	)
+
+	tests := []struct {
+		c    Coordinate
+		want bool
+	}{
+		{NewCoordinate(1, 1), true},
+		{NewCoordinate(3, 2), false},
+		{NewCoordinate(3, 5), true},
+		{NewCoordinate(5, 3), true},
+		{NewCoordinate(2, 2), false},
+		{NewCoordinate(7, 3), false},
+	}
+
+	for _, tt := range tests {
+		if got := p.Contains(tt.c); got != tt.want {
+			t.Errorf("Contains(%s) = %v, want %v", tt.c, got, tt.want)
+		}
+	}
+	if !p.OnBoundary(NewCoordinate(2, 2)) {
+		t.Errorf("OnBoundary(%s) = false, want true", NewCoordinate(2, 2))
+	}
+}
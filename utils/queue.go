@@ -10,50 +10,56 @@ var (
 	ErrKeyAlreadyExists = errors.New("key already exists")
 )
 
+// Queue is first in, first out
 type Queue[T any] struct {
-	data []T
+	d Deque[T]
 }
 
 func NewQueue[T any]() *Queue[T] {
-	return &Queue[T]{
-		data: []T{},
-	}
+	return &Queue[T]{}
 }
 
 func (q *Queue[T]) Push(v T) {
-	q.data = append(q.data, v)
+	q.d.PushBack(v)
 }
 
 func (q *Queue[T]) Pop() T {
 	if q.Len() == 0 {
 		panic("queue empty")
 	}
+	return q.d.PopFront()
+}
 
-	v := q.data[0]
-	q.data = q.data[1:]
-	return v
+// Peek returns what Pop would without removing it
+func (q *Queue[T]) Peek() T {
+	if q.Len() == 0 {
+		panic("queue empty")
+	}
+	return q.d.PeekFront()
 }
 
 func (q *Queue[T]) Len() int {
-	return len(q.data)
+	return q.d.Len()
 }
 
-type item[K comparable, P cmp.Ordered] struct {
+type item[K comparable, P any] struct {
 	value    K
 	priority P
 	index    int
 }
 
-type heapPriorityQueue[K comparable, P cmp.Ordered] struct {
+type heapPriorityQueue[K comparable, P any] struct {
 	items        []*item[K, P]
 	idxMap       map[K]int
+	compare      func(a, b P) int
 	inverseOrder bool
 }
 
-func newHeapPriorityQueue[K comparable, P cmp.Ordered]() *heapPriorityQueue[K, P] {
+func newHeapPriorityQueue[K comparable, P any](compare func(a, b P) int) *heapPriorityQueue[K, P] {
 	return &heapPriorityQueue[K, P]{
-		items:  []*item[K, P]{},
-		idxMap: map[K]int{},
+		items:   []*item[K, P]{},
+		idxMap:  map[K]int{},
+		compare: compare,
 	}
 }
 
@@ -63,9 +69,9 @@ func (hpq heapPriorityQueue[_, _]) Len() int {
 
 func (hpq heapPriorityQueue[_, _]) Less(i, j int) bool {
 	if hpq.inverseOrder {
-		return hpq.items[i].priority > hpq.items[j].priority
+		return hpq.compare(hpq.items[i].priority, hpq.items[j].priority) > 0
 	}
-	return hpq.items[i].priority < hpq.items[j].priority
+	return hpq.compare(hpq.items[i].priority, hpq.items[j].priority) < 0
 }
 
 func (hpq heapPriorityQueue[_, _]) Swap(i, j int) {
@@ -97,18 +103,28 @@ func (hpq *heapPriorityQueue[_, _]) Pop() any {
 	return popItem
 }
 
-type PriorityQueue[K comparable, P cmp.Ordered] struct {
+// PriorityQueue pops the value with the lowest priority first, or the highest if SetPriorityOrder is
+// used to flip it. Each value can only be in the queue once.
+type PriorityQueue[K comparable, P any] struct {
 	hpq *heapPriorityQueue[K, P]
 }
 
 func NewPriorityQueue[K comparable, P cmp.Ordered]() *PriorityQueue[K, P] {
+	return NewPriorityQueueFunc[K](cmp.Compare[P])
+}
+
+// NewPriorityQueueFunc makes a PriorityQueue for priorities that aren't ordered on their own, like
+// structs. compare returns a negative number when a comes before b, a positive one when b comes
+// before a, and 0 when they're tied, the same as cmp.Compare.
+func NewPriorityQueueFunc[K comparable, P any](compare func(a, b P) int) *PriorityQueue[K, P] {
 	return &PriorityQueue[K, P]{
-		hpq: newHeapPriorityQueue[K, P](),
+		hpq: newHeapPriorityQueue[K, P](compare),
 	}
 }
 
 func (pq *PriorityQueue[K, P]) SetPriorityOrder(inverseOrder bool) {
 	pq.hpq.inverseOrder = inverseOrder
+	heap.Init(pq.hpq)
 }
 
 func (pq *PriorityQueue[_, _]) Len() int {
@@ -134,6 +150,7 @@ func (pq *PriorityQueue[K, P]) Init(vals map[K]P) {
 	heap.Init(pq.hpq)
 }
 
+// Update changes v's priority, pushing it if it isn't in the queue yet
 func (pq *PriorityQueue[K, P]) Update(v K, priority P) {
 	i, ok := pq.hpq.idxMap[v]
 
@@ -147,7 +164,47 @@ func (pq *PriorityQueue[K, P]) Update(v K, priority P) {
 }
 
 func (pq *PriorityQueue[K, P]) Pop() (K, P) {
+	if pq.Len() == 0 {
+		panic("priority queue empty")
+	}
+
 	popItem := heap.Pop(pq.hpq).(*item[K, P])
 
 	return popItem.value, popItem.priority
 }
+
+// Peek returns what Pop would without removing it
+func (pq *PriorityQueue[K, P]) Peek() (K, P) {
+	if pq.Len() == 0 {
+		panic("priority queue empty")
+	}
+
+	top := pq.hpq.items[0]
+	return top.value, top.priority
+}
+
+func (pq *PriorityQueue[K, _]) Contains(v K) bool {
+	_, ok := pq.hpq.idxMap[v]
+	return ok
+}
+
+// Priority returns v's priority, and false if v isn't in the queue
+func (pq *PriorityQueue[K, P]) Priority(v K) (P, bool) {
+	i, ok := pq.hpq.idxMap[v]
+	if !ok {
+		var zero P
+		return zero, false
+	}
+	return pq.hpq.items[i].priority, true
+}
+
+// Remove takes v out of the queue, returning false if it wasn't in it
+func (pq *PriorityQueue[K, _]) Remove(v K) bool {
+	i, ok := pq.hpq.idxMap[v]
+	if !ok {
+		return false
+	}
+
+	heap.Remove(pq.hpq, i)
+	return true
+}
@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+	"testing/quick"
+)
+
+func BenchmarkPriorityQueue(b *testing.B) {
+	for _, n := range []int{100, 10_000} {
+		b.Run(fmt.Sprintf("push-pop%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				pq := NewPriorityQueue[int, int]()
+				for j := 0; j < n; j++ {
+					pq.Push(j, (j*7919)%n)
+				}
+				for pq.Len() > 0 {
+					pq.Pop()
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("init-update%d", n), func(b *testing.B) {
+			vals := make(map[int]int, n)
+			for j := 0; j < n; j++ {
+				vals[j] = n
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				pq := NewPriorityQueue[int, int]()
+				pq.Init(vals)
+				for j := 0; j < n; j++ {
+					pq.Update(j, (j*7919)%n)
+				}
+			}
+		})
+	}
+}
+
+// pqOracle is a slow but obviously right priority queue, kept sorted by priority
+type pqOracle struct {
+	keys       []int
+	priorities map[int]int
+}
+
+func (o *pqOracle) sort() {
+	slices.SortStableFunc(o.keys, func(a, b int) int {
+		return cmp.Compare(o.priorities[a], o.priorities[b])
+	})
+}
+
+func (o *pqOracle) remove(k int) {
+	o.keys = slices.DeleteFunc(o.keys, func(v int) bool { return v == k })
+	delete(o.priorities, k)
+}
+
+// checkAgainstOracle runs ops against both the priority queue and the oracle. Each op picks what to
+// do and which key and priority to do it with.
+func checkAgainstOracle(ops []uint16) bool {
+	pq := NewPriorityQueue[int, int]()
+	o := &pqOracle{priorities: map[int]int{}}
+
+	for _, op := range ops {
+		k, priority := int(op>>4)%16, int(op>>8)%8
+
+		switch op % 6 {
+		case 0, 1:
+			pq.Update(k, priority)
+			if _, ok := o.priorities[k]; !ok {
+				o.keys = append(o.keys, k)
+			}
+			o.priorities[k] = priority
+			o.sort()
+		case 2:
+			_, inOracle := o.priorities[k]
+			if pq.Remove(k) != inOracle {
+				return false
+			}
+			o.remove(k)
+		case 3:
+			if len(o.keys) == 0 {
+				continue
+			}
+			// ties can come out in any order, so only the priority has to match
+			v, p := pq.Pop()
+			if p != o.priorities[o.keys[0]] || o.priorities[v] != p {
+				return false
+			}
+			o.remove(v)
+		case 4:
+			if len(o.keys) == 0 {
+				continue
+			}
+			v, p := pq.Peek()
+			if p != o.priorities[o.keys[0]] || o.priorities[v] != p {
+				return false
+			}
+		case 5:
+			want, inOracle := o.priorities[k]
+			got, ok := pq.Priority(k)
+			if ok != inOracle || pq.Contains(k) != inOracle || (ok && got != want) {
+				return false
+			}
+		}
+
+		if pq.Len() != len(o.keys) {
+			return false
+		}
+	}
+
+	// everything left should come out in order
+	for _, k := range o.keys {
+		if _, p := pq.Pop(); p != o.priorities[k] {
+			return false
+		}
+	}
+	return pq.Len() == 0
+}
+
+func TestPriorityQueueAgainstOracle(t *testing.T) {
+	config := &quick.Config{
+		MaxCount: 500,
+		Rand:     rand.New(rand.NewSource(20)),
+	}
+	if err := quick.Check(checkAgainstOracle, config); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPriorityQueueFunc(t *testing.T) {
+	type priority struct {
+		cost  int
+		steps int
+	}
+
+	// lowest cost first, and the most steps when costs tie
+	pq := NewPriorityQueueFunc[string](func(a, b priority) int {
+		if c := cmp.Compare(a.cost, b.cost); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.steps, a.steps)
+	})
+	pq.Push("a", priority{cost: 5, steps: 1})
+	pq.Push("b", priority{cost: 3, steps: 1})
+	pq.Push("c", priority{cost: 3, steps: 4})
+	pq.Push("d", priority{cost: 9, steps: 0})
+
+	got := []string{}
+	for pq.Len() > 0 {
+		v, _ := pq.Pop()
+		got = append(got, v)
+	}
+	if want := []string{"c", "b", "a", "d"}; !slices.Equal(got, want) {
+		t.Errorf("popped %v, want %v", got, want)
+	}
+
+	pq.Push("x", priority{cost: 1})
+	pq.Push("y", priority{cost: 2})
+	pq.SetPriorityOrder(true)
+	if v, _ := pq.Peek(); v != "y" {
+		t.Errorf("expected y first after flipping the order, got %s", v)
+	}
+}
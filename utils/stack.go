@@ -1,30 +1,33 @@
 package utils
 
+// Stack is last in, first out
 type Stack[T any] struct {
-	data []T
+	d Deque[T]
 }
 
 func NewStack[T any]() *Stack[T] {
-	return &Stack[T]{
-		data: []T{},
-	}
+	return &Stack[T]{}
 }
 
 func (s *Stack[T]) Push(v T) {
-	s.data = append(s.data, v)
+	s.d.PushBack(v)
 }
 
 func (s *Stack[T]) Pop() T {
 	if s.Len() == 0 {
 		panic("stack empty")
 	}
+	return s.d.PopBack()
+}
 
-	v := s.data[len(s.data)-1]
-	s.data = s.data[:len(s.data)-1]
-
-	return v
+// Peek returns what Pop would without removing it
+func (s *Stack[T]) Peek() T {
+	if s.Len() == 0 {
+		panic("stack empty")
+	}
+	return s.d.PeekBack()
 }
 
 func (s *Stack[T]) Len() int {
-	return len(s.data)
+	return s.d.Len()
 }
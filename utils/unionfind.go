@@ -0,0 +1,96 @@
+package utils
+
+// UnionFind keeps track of which group each value is in as groups get merged together
+type UnionFind[K comparable] struct {
+	parent map[K]K
+	size   map[K]int
+	groups int
+}
+
+func NewUnionFind[K comparable]() *UnionFind[K] {
+	return &UnionFind[K]{
+		parent: map[K]K{},
+		size:   map[K]int{},
+	}
+}
+
+// Add puts v in a group of its own, if it isn't already in one
+func (uf *UnionFind[K]) Add(v K) {
+	if _, ok := uf.parent[v]; ok {
+		return
+	}
+	uf.parent[v] = v
+	uf.size[v] = 1
+	uf.groups++
+}
+
+// Find returns the value that represents v's group, adding v first if it's new
+func (uf *UnionFind[K]) Find(v K) K {
+	uf.Add(v)
+
+	root := v
+	for uf.parent[root] != root {
+		root = uf.parent[root]
+	}
+
+	// point everything on the way straight at the root so the next Find is quicker
+	for v != root {
+		next := uf.parent[v]
+		uf.parent[v] = root
+		v = next
+	}
+
+	return root
+}
+
+// Union merges the groups a and b are in, returning false if they were already in the same one
+func (uf *UnionFind[K]) Union(a, b K) bool {
+	rootA, rootB := uf.Find(a), uf.Find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	// hang the smaller group off of the bigger one to keep the trees shallow
+	if uf.size[rootA] < uf.size[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	uf.parent[rootB] = rootA
+	uf.size[rootA] += uf.size[rootB]
+	delete(uf.size, rootB)
+	uf.groups--
+
+	return true
+}
+
+func (uf *UnionFind[K]) Connected(a, b K) bool {
+	return uf.Find(a) == uf.Find(b)
+}
+
+// Size is how many values are in v's group
+func (uf *UnionFind[K]) Size(v K) int {
+	return uf.size[uf.Find(v)]
+}
+
+// Len is how many separate groups there are
+func (uf *UnionFind[K]) Len() int {
+	return uf.groups
+}
+
+// Groups returns the values in each group
+func (uf *UnionFind[K]) Groups() [][]K {
+	idxs := map[K]int{}
+	groups := [][]K{}
+
+	for v := range uf.parent {
+		root := uf.Find(v)
+		i, ok := idxs[root]
+		if !ok {
+			i = len(groups)
+			idxs[root] = i
+			groups = append(groups, []K{})
+		}
+		groups[i] = append(groups[i], v)
+	}
+
+	return groups
+}
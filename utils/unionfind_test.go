@@ -0,0 +1,50 @@
+package utils
+
+import "testing"
+
+func TestUnionFind(t *testing.T) {
+	uf := NewUnionFind[int]()
+	for i := 0; i < 10; i++ {
+		uf.Add(i)
+	}
+	if uf.Len() != 10 {
+		t.Fatalf("got %d groups, want 10", uf.Len())
+	}
+
+	// evens together, odds together
+	for i := 2; i < 10; i++ {
+		if !uf.Union(i, i-2) {
+			t.Errorf("Union(%d, %d) should have merged", i, i-2)
+		}
+	}
+	if uf.Union(0, 8) {
+		t.Error("Union(0, 8) shouldn't have merged, they're already together")
+	}
+
+	if uf.Len() != 2 {
+		t.Errorf("got %d groups, want 2", uf.Len())
+	}
+	if !uf.Connected(1, 9) || uf.Connected(1, 2) {
+		t.Error("odds should be connected to each other and not to evens")
+	}
+	if uf.Size(4) != 5 {
+		t.Errorf("Size(4) = %d, want 5", uf.Size(4))
+	}
+
+	for _, group := range uf.Groups() {
+		if len(group) != 5 {
+			t.Errorf("got group %v, want 5 values", group)
+		}
+		for _, v := range group {
+			if v%2 != group[0]%2 {
+				t.Errorf("group %v mixes evens and odds", group)
+			}
+		}
+	}
+
+	// new values are added as they're found
+	uf.Union(1, 100)
+	if uf.Size(100) != 6 || uf.Len() != 2 {
+		t.Errorf("100 should have joined the odds, got size %d and %d groups", uf.Size(100), uf.Len())
+	}
+}